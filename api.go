@@ -28,10 +28,5 @@ func Parse(input string) (core.Expr, error) {
 
 // EvaluateString is a convenience function that parses and evaluates a string
 func EvaluateString(input string) (core.Expr, error) {
-	expr, err := core.ParseString(input)
-	if err != nil {
-		return nil, err
-	}
-	e := NewEvaluator()
-	return e.Evaluate(expr), nil
+	return NewEvaluator().EvaluateString(input)
 }
@@ -6,6 +6,7 @@ import (
 )
 
 // @ExprSymbol Abs
+// @ExprAttributes NumericFunction Protected
 
 // @ExprPattern (_Integer)
 func AbsInteger(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
@@ -0,0 +1,17 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol AbsoluteTiming
+// @ExprAttributes HoldAll Protected
+
+// AbsoluteTiming evaluates expr once and returns [seconds, result],
+// where seconds is the wall clock time taken
+//
+// @ExprPattern (_)
+func AbsoluteTiming(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return timeEvaluation(e, args[0])
+}
@@ -22,3 +22,11 @@ func AppendString(e *engine.Evaluator, c *engine.Context, args []core.Expr) core
 	b := args[1].(core.String)
 	return core.NewString(string(a) + string(b))
 }
+
+// AppendAssociation adds or overwrites keys, returning a new association:
+// Append({"a": 1}, "b": 2) or Append(assoc, ["a": 0, "b": 2])
+//
+// @ExprPattern (_Association, _)
+func AppendAssociation(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return associateRules(args[0].(core.Association), args[1])
+}
@@ -2,18 +2,25 @@ package builtins
 
 import (
 	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
 	"github.com/client9/cardinal/engine"
 )
 
 // @ExprSymbol Assert
-// TODO: direct atom check
+// @ExprAttributes HoldAll Protected
 
+// Assert evaluates cond and returns Null if it is True, otherwise an
+// AssertionError showing the unevaluated condition:
+// Assert(1 + 1 == 3) is an error "assertion 1 + 1 == 3 failed"
+//
 // @ExprPattern (_)
 func Assert(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	arg := args[0]
-	result := e.Evaluate(arg)
-	if symbolName, ok := core.ExtractSymbol(result); ok && symbolName == "True" {
+	result := e.Evaluate(args[0])
+	if core.IsError(result) {
 		return result
 	}
-	return core.NewError("AssertionFailed", arg.InputForm())
+	if result != symbol.True {
+		return core.NewError("AssertionError", "assertion "+args[0].InputForm()+" failed")
+	}
+	return symbol.Null
 }
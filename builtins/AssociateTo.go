@@ -0,0 +1,56 @@
+package builtins
+
+import (
+	"fmt"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol AssociateTo
+// @ExprAttributes HoldFirst Protected
+
+// AssociateTo adds or overwrites keys in the association stored in a
+// variable, and returns the new association:
+// a = {"x": 1}; AssociateTo(a, "x": 2)
+// Associations are immutable, so the variable is set to a new one.
+//
+// @ExprPattern (_Symbol, _)
+func AssociateTo(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	name := args[0].(core.Symbol)
+	value, ok := c.Get(name)
+	if !ok {
+		return core.NewError("ArgumentError", fmt.Sprintf("%s has no value", name))
+	}
+	assoc, ok := value.(core.Association)
+	if !ok {
+		return core.NewError("TypeError", fmt.Sprintf("%s is not an association", name))
+	}
+	result := associateRules(assoc, args[1])
+	if core.IsError(result) {
+		return result
+	}
+	if err := c.Set(name, result); err != nil {
+		return core.NewError("Protected", err.Error())
+	}
+	return result
+}
+
+// associateRules returns assoc with the rule, or list of rules, added
+func associateRules(assoc core.Association, rules core.Expr) core.Expr {
+	list := []core.Expr{rules}
+	if rules.Head() == symbol.List {
+		list = rules.(core.List).Tail()
+	}
+	for _, rule := range list {
+		ruleList, ok := rule.(core.List)
+		if !ok || ruleList.Head() != symbol.Rule || ruleList.Length() != 2 {
+			return core.NewError("ArgumentError",
+				fmt.Sprintf("expected Rule expressions, got %s", rule.String()))
+		}
+		ruleArgs := ruleList.Tail()
+		assoc = assoc.Set(ruleArgs[0], ruleArgs[1])
+	}
+	return assoc
+}
@@ -0,0 +1,55 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/big"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Binomial
+// @ExprAttributes Listable NumericFunction Protected
+
+// Binomial is the number of ways to choose k items from n.  It is 0
+// when k < 0 or k > n.
+//
+// @ExprPattern (_Integer, _Integer)
+func Binomial(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	n := args[0].(core.Integer)
+	k := args[1].(core.Integer)
+	if k.Sign() < 0 || core.CompareNumbers(k, n) > 0 {
+		return core.NewInteger(0)
+	}
+	if !n.IsInt64() {
+		return bigBinomial(n.AsBigInt(), k.AsBigInt(), args)
+	}
+	nv, kv := n.Int64(), k.Int64()
+	if kv > nv-kv {
+		kv = nv - kv
+	}
+	return normalizeBigInt(new(big.Int).Binomial(nv, kv))
+}
+
+// maxBigBinomialK is the largest k, or n - k, for which Binomial of a big
+// n is computed, larger results are left unevaluated
+const maxBigBinomialK = 1 << 16
+
+// bigBinomial computes Binomial(n, k) for an n too big for an int64 as
+// the product n (n-1) ... (n-k+1) / k!, using the smaller of k and n - k
+func bigBinomial(n, k *big.Int, args []core.Expr) core.Expr {
+	if rest := new(big.Int).Sub(n, k); rest.Cmp(k) < 0 {
+		k = rest
+	}
+	if !k.IsInt64() || k.Int64() > maxBigBinomialK {
+		return core.ListFrom(symbol.Binomial, args...)
+	}
+	r := big.NewInt(1)
+	factor := new(big.Int).Set(n)
+	for i := int64(1); i <= k.Int64(); i++ {
+		// r stays an integer: it is Binomial(n, i) after each step
+		r.Mul(r, factor)
+		r.Quo(r, big.NewInt(i))
+		factor.Sub(factor, big.NewInt(1))
+	}
+	return normalizeBigInt(r)
+}
@@ -71,7 +71,7 @@ func saveBlockVars(e *engine.Evaluator, c *engine.Context, vars core.Expr) (map[
 
 		setvar, ok := arg.(core.List)
 		if !ok || setvar.Length() != 2 || setvar.Head() != symbol.Set {
-			return nil, fmt.Errorf("variable not a symbol or assignment. %s, len=%d, head=%s", setvar.String(), setvar.Length(), setvar.String())
+			return nil, fmt.Errorf("variable not a symbol or assignment. %s", arg.String())
 			// ERROR
 		}
 		setvarArgs := setvar.Tail()
@@ -7,7 +7,11 @@ import (
 )
 
 // @ExprSymbol Boole
+// @ExprAttributes Listable Protected
 
+// Boole converts True to 1 and False to 0.  Anything else stays
+// unevaluated.
+//
 // @ExprPattern (_)
 func Boole(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	arg := args[0]
@@ -0,0 +1,43 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Cases
+
+// Cases returns the elements of expr that match pattern:
+// Cases([1, 2, 3, 4], x_ /; x > 2) returns [3, 4]
+// If pattern is a rule, the matching elements are replaced:
+// Cases([1, a, 2], x_Integer : x * 10) returns [10, 20]
+//
+// @ExprPattern (_(___), _)
+func Cases(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	list := args[0].(core.List)
+	pattern := args[1]
+
+	var replacement core.Expr
+	if lhs, rhs, ok := asRule(pattern); ok {
+		pattern, replacement = lhs, rhs
+	}
+
+	var result []core.Expr
+	for _, element := range list.Tail() {
+		matches, bindings := e.Match(element, pattern)
+		if !matches {
+			continue
+		}
+		if replacement == nil {
+			result = append(result, element)
+			continue
+		}
+		value := e.Evaluate(core.SubstituteBindings(replacement, bindings))
+		if core.IsError(value) {
+			return value
+		}
+		result = append(result, value)
+	}
+	return core.ListFrom(symbol.List, result...)
+}
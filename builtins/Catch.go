@@ -0,0 +1,44 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Catch
+// @ExprAttributes HoldAll Protected
+
+// Catch evaluates body and returns the value of the first Throw(value)
+// inside it, or the result of body if nothing is thrown.
+//
+// @ExprPattern (_)
+func Catch(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	result := e.Evaluate(args[0])
+	if err, ok := core.AsError(result); ok {
+		if value, tag, thrown := err.AsThrow(); thrown && tag == nil {
+			return value
+		}
+	}
+	return result
+}
+
+// CatchTag only catches a Throw(value, tag) whose tag matches form:
+// Catch(Throw(1, "done"), "done")
+// Other throws keep unwinding to an outer Catch.
+//
+// @ExprPattern (_, _)
+func CatchTag(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	form := e.Evaluate(args[1])
+	if core.IsError(form) {
+		return form
+	}
+	result := e.Evaluate(args[0])
+	if err, ok := core.AsError(result); ok {
+		if value, tag, thrown := err.AsThrow(); thrown && tag != nil {
+			if matched, _ := e.Match(tag, form); matched {
+				return value
+			}
+		}
+	}
+	return result
+}
@@ -21,16 +21,17 @@ func CeilingInteger(e *engine.Evaluator, c *engine.Context, args []core.Expr) co
 func CeilingReal(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	r := args[0].(core.Real)
 	if r.IsFloat64() {
-		return core.NewInteger(int64(math.Ceil(r.Float64())))
+		return integerFromFloat64(math.Ceil(r.Float64()))
 	}
 
 	// Big Real
 	return new(big.Float).Ceil(r.AsBigFloat()).Int()
 }
 
-/*
 // @ExprPattern (_Rational)
-func FloorRational(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	return args[0].(core.Rational).AsNum()
+func CeilingRational(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	// Ceiling(x) is -Floor(-x)
+	r := args[0].(core.Rational).AsBigRat()
+	f := floorRat(new(big.Rat).Neg(r))
+	return f.AsNeg()
 }
-*/
@@ -0,0 +1,29 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Check
+// @ExprAttributes HoldAll Protected
+
+// Check evaluates expr, and returns the value of failexpr instead if
+// an error or a Message was produced along the way:
+// Check(1/0, "failed") is "failed".  A Throw passes through.
+//
+// @ExprPattern (_, _)
+func Check(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	errors, messages := c.ErrorCount(), len(c.Messages())
+	result := e.Evaluate(args[0])
+	if err, ok := core.AsError(result); ok {
+		if _, _, thrown := err.AsThrow(); thrown {
+			return result
+		}
+		return e.Evaluate(args[1])
+	}
+	if c.ErrorCount() > errors || len(c.Messages()) > messages {
+		return e.Evaluate(args[1])
+	}
+	return result
+}
@@ -9,10 +9,28 @@ import (
 // @ExprSymbol Clear
 // @ExprAttributes HoldAll
 
+// Clear removes the values and definitions of symbols, keeping their
+// attributes: Clear(x, f)
+//
 // @ExprPattern (___Symbol)
 func Clear(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if err := checkProtected(c, args); err != nil {
+		return err
+	}
 	for _, arg := range args {
 		c.Clear(arg.(core.Symbol))
 	}
 	return symbol.Null
 }
+
+// checkProtected returns an error if any of the symbols is Protected
+func checkProtected(c *engine.Context, args []core.Expr) core.Expr {
+	symbolTable := c.GetSymbolTable()
+	for _, arg := range args {
+		sym := arg.(core.Symbol)
+		if symbolTable.HasAttribute(sym, engine.Protected) {
+			return core.NewError("Protected", "symbol "+sym.String()+" is Protected")
+		}
+	}
+	return nil
+}
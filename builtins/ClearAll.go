@@ -0,0 +1,24 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol ClearAll
+// @ExprAttributes HoldAll Protected
+
+// ClearAll removes the values, definitions and attributes of symbols:
+// ClearAll(x, f)
+//
+// @ExprPattern (___Symbol)
+func ClearAll(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if err := checkProtected(c, args); err != nil {
+		return err
+	}
+	for _, arg := range args {
+		c.ClearAll(arg.(core.Symbol))
+	}
+	return symbol.Null
+}
@@ -14,23 +14,33 @@ import (
 // @ExprPattern (_Symbol, _Symbol)
 func ClearAttributesSingle(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	sym := args[0].(core.Symbol)
-	attrName := args[1].(core.Symbol)
+	attr := engine.SymbolToAttribute(args[1].(core.Symbol))
+	if attr == 0 {
+		return core.NewError("UnknownAttribute", "unknown attribute")
+	}
+	if err := checkAttributeChange(c, sym, attr); err != nil {
+		return err
+	}
 	symbolTable := c.GetSymbolTable()
-	symbolTable.ClearAttributes(sym, engine.SymbolToAttribute(attrName))
+	symbolTable.ClearAttributes(sym, attr)
 	return symbol.Null
 }
 
-// TODO: no error handling
-//
-// @ExprPattern (_Symbol, List(___Symbol)))
+// @ExprPattern (_Symbol, List(___Symbol))
 func ClearAttributesList(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	sym := args[0].(core.Symbol)
 	attrList := args[1].(core.List)
 	symbolTable := c.GetSymbolTable()
 	var attributes engine.Attribute
 	for _, arg := range attrList.Tail() {
-		attrName := arg.(core.Symbol)
-		attributes |= engine.SymbolToAttribute(attrName)
+		attr := engine.SymbolToAttribute(arg)
+		if attr == 0 {
+			return core.NewError("UnknownAttribute", "unknown attribute")
+		}
+		attributes |= attr
+	}
+	if err := checkAttributeChange(c, sym, attributes); err != nil {
+		return err
 	}
 	if attributes != 0 {
 		symbolTable.ClearAttributes(sym, attributes)
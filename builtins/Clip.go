@@ -0,0 +1,49 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Clip
+// @ExprAttributes Protected
+
+// Clip clamps a number to the range [-1, 1]
+//
+// @ExprPattern (_)
+func Clip(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if x, ok := clip(args[0], core.NewInteger(-1), core.NewInteger(1)); ok {
+		return x
+	}
+	return core.NewList(symbol.Clip, args...)
+}
+
+// ClipRange clamps a number to the range [lo, hi]: Clip(15, [0, 10]) is 10
+// Values already in range are returned unchanged.
+//
+// @ExprPattern (_, List(_, _))
+func ClipRange(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	bounds := args[1].(core.List).Tail()
+	if x, ok := clip(args[0], bounds[0], bounds[1]); ok {
+		return x
+	}
+	return core.NewList(symbol.Clip, args...)
+}
+
+// clip clamps x to [lo, hi], failing if any of them is not a number
+func clip(x, lo, hi core.Expr) (core.Expr, bool) {
+	xn, xok := x.(core.Number)
+	lon, look := lo.(core.Number)
+	hin, hiok := hi.(core.Number)
+	if !xok || !look || !hiok {
+		return nil, false
+	}
+	if core.CompareNumbers(xn, lon) < 0 {
+		return lo, true
+	}
+	if core.CompareNumbers(xn, hin) > 0 {
+		return hi, true
+	}
+	return x, true
+}
@@ -0,0 +1,9 @@
+package builtins
+
+// @ExprSymbol Condition
+// @ExprAttributes HoldAll
+//
+// Condition(pattern, test) or pattern /; test matches only when test
+// evaluates to True with the pattern variables bound.  As the right-hand
+// side of a SetDelayed, f(x_) := body /; test, the definition applies only
+// when test holds, otherwise the next definition is tried.
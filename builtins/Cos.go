@@ -5,6 +5,7 @@ import (
 
 	"github.com/client9/cardinal/core"
 	"github.com/client9/cardinal/core/big"
+	"github.com/client9/cardinal/core/symbol"
 	"github.com/client9/cardinal/engine"
 )
 
@@ -19,3 +20,14 @@ func Cos(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	}
 	return new(big.Float).SetPrec(r.Prec()).Cos(r.AsBigFloat())
 }
+
+// CosExact gives exact values at multiples of Pi/2
+//
+// @ExprPattern (_)
+func CosExact(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	k, ok := halfPiMultiple(args[0])
+	if !ok {
+		return core.ListFrom(symbol.Cos, args[0])
+	}
+	return core.NewInteger([]int64{1, 0, -1, 0}[k])
+}
@@ -0,0 +1,25 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Count
+
+// Count returns the number of elements of expr that match pattern:
+// Count([1, a, 2, b], _?IntegerQ) returns 2
+//
+// @ExprPattern (_(___), _)
+func Count(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	list := args[0].(core.List)
+	pattern := args[1]
+
+	var n int64
+	for _, element := range list.Tail() {
+		if matches, _ := e.Match(element, pattern); matches {
+			n++
+		}
+	}
+	return core.NewInteger(n)
+}
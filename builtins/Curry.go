@@ -0,0 +1,20 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Curry
+
+// Curry partially applies f to the given arguments: Curry(f, a, b)
+// returns a pure function that calls f(a, b, ...) with any remaining
+// arguments appended, so Curry(f, 1, 2)(3) is f(1, 2, 3).
+//
+// @ExprPattern (_, ___)
+func Curry(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	call := make([]core.Expr, 0, len(args)+1)
+	call = append(call, args...)
+	call = append(call, core.NewSymbol("$$"))
+	return core.NewFunction(nil, core.NewListFromExprs(call...))
+}
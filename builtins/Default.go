@@ -0,0 +1,40 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Default
+// @ExprAttributes HoldAll Protected
+
+// Default returns the value an omitted x_. argument of a function takes,
+// set with Default(f) = value:
+// Default(f) = 0; f(x_, y_.) := [x, y]; f(5)
+// [5, 0]
+// Without a default, x_. arguments can't be omitted.
+//
+// @ExprPattern (_Symbol)
+func Default(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if value, ok := c.GetSymbolTable().Default(args[0].(core.Symbol)); ok {
+		return value
+	}
+	return core.ListFrom(symbol.Default, args...)
+}
+
+// setDefault implements Default(sym) = value
+func setDefault(c *engine.Context, lhs core.List, value core.Expr) core.Expr {
+	sym, ok := lhs.Tail()[0].(core.Symbol)
+	if !ok {
+		return core.NewError("ArgumentError", "Default expects a symbol")
+	}
+	if c.GetSymbolTable().HasAttribute(sym, engine.Protected) {
+		return core.NewError("Protected", "symbol "+sym.String()+" is Protected")
+	}
+	if core.IsError(value) {
+		return value
+	}
+	c.GetSymbolTable().SetDefault(sym, value)
+	return value
+}
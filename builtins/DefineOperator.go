@@ -0,0 +1,42 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol DefineOperator
+
+// DefineOperator registers a user-defined infix operator for later input:
+// DefineOperator("~", f, 115) makes a ~ b parse as f(a, b).
+//
+// The operator must be one of "~", "**" or "<>".  Precedence is compared
+// with the builtin operators, see OperatorPrecedence; e.g. "+" is 110 and
+// "*" is 120.  Operators are left-associative.
+//
+// @ExprPattern (_String, _, _Integer)
+func DefineOperator(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return defineOperator(e, args[0], args[1], args[2], false)
+}
+
+// DefineOperatorAssoc is DefineOperator with associativity "Left" or "Right"
+//
+// @ExprPattern (_String, _, _Integer, _String)
+func DefineOperatorAssoc(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	switch args[3].(core.String) {
+	case "Left":
+		return defineOperator(e, args[0], args[1], args[2], false)
+	case "Right":
+		return defineOperator(e, args[0], args[1], args[2], true)
+	}
+	return core.NewError("ArgumentError", "DefineOperator associativity must be \"Left\" or \"Right\"")
+}
+
+func defineOperator(e *engine.Evaluator, op, head, prec core.Expr, rightAssoc bool) core.Expr {
+	n, _ := core.ExtractInt64(prec)
+	if err := e.Operators().Define(string(op.(core.String)), head, core.Precedence(n), rightAssoc); err != nil {
+		return core.NewError("ArgumentError", err.Error())
+	}
+	return symbol.Null
+}
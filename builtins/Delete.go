@@ -0,0 +1,105 @@
+package builtins
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Delete
+// @ExprAttributes Protected
+
+// Delete removes the element at a position.  The position is an index,
+// a path [i, j, ...] into nested expressions, or a list of paths
+// [[i], [j, k]] as returned by Position.  Negative indices count from
+// the end.
+//
+// @ExprPattern (_, _)
+func Delete(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	expr := args[0]
+	paths, err := partPaths(expr, args[1])
+	if err != nil {
+		return err
+	}
+
+	// Delete from the end so earlier positions stay valid
+	slices.SortFunc(paths, func(a, b []int64) int { return -slices.Compare(a, b) })
+	paths = slices.CompactFunc(paths, slices.Equal)
+	for _, path := range paths {
+		if len(path) == 0 || path[len(path)-1] == 0 {
+			return core.NewError("PartError", "Delete can not remove a head or the whole expression")
+		}
+		expr = deleteAt(expr, path)
+	}
+	return expr
+}
+
+func deleteAt(expr core.Expr, path []int64) core.Expr {
+	list := expr.(core.List)
+	elements := list.AsSlice()
+	n := path[0]
+	if len(path) == 1 {
+		return core.NewListFromExprs(slices.Delete(slices.Clone(elements), int(n), int(n)+1)...)
+	}
+	elements = slices.Clone(elements)
+	elements[n] = deleteAt(elements[n], path[1:])
+	return core.NewListFromExprs(elements...)
+}
+
+// partPaths converts a position spec into normalized index paths, with
+// negative indices resolved against expr.  The spec is an index, a path
+// [i, j, ...], or a list of paths [[i], [j, k]].  Index 0 is the head.
+func partPaths(expr core.Expr, spec core.Expr) ([][]int64, core.Expr) {
+	list, ok := spec.(core.List)
+	if !ok || list.Head() != symbol.List {
+		path, err := partPath(expr, []core.Expr{spec})
+		return [][]int64{path}, err
+	}
+	if list.Length() > 0 {
+		if _, nested := list.Tail()[0].(core.List); nested {
+			var paths [][]int64
+			for _, elem := range list.Tail() {
+				p, ok := elem.(core.List)
+				if !ok || p.Head() != symbol.List {
+					return nil, core.NewError("PartError", fmt.Sprintf("Invalid position %s", elem))
+				}
+				path, err := partPath(expr, p.Tail())
+				if err != nil {
+					return nil, err
+				}
+				paths = append(paths, path)
+			}
+			return paths, nil
+		}
+	}
+	path, err := partPath(expr, list.Tail())
+	return [][]int64{path}, err
+}
+
+// partPath checks that each index exists, resolving negative indices
+func partPath(expr core.Expr, indices []core.Expr) ([]int64, core.Expr) {
+	path := make([]int64, len(indices))
+	for i, index := range indices {
+		list, ok := expr.(core.List)
+		if !ok {
+			return nil, core.NewError("PartError", fmt.Sprintf("Part %s of %s does not exist", index, expr))
+		}
+		n, ok := core.ExtractInt64(index)
+		if !ok {
+			return nil, core.NewError("PartError", fmt.Sprintf("Position index %s is not an integer", index))
+		}
+		length := list.Length()
+		if n < 0 {
+			n = length + n + 1
+		}
+		if n < 0 || n > length {
+			return nil, core.NewError("PartError", fmt.Sprintf("Part %s of %s does not exist", index, expr))
+		}
+		path[i] = n
+		expr = list.AsSlice()[n]
+	}
+	return path, nil
+}
@@ -0,0 +1,24 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol DeleteDuplicates
+
+// DeleteDuplicates keeps the first of each group of equal elements,
+// in their original order: DeleteDuplicates([b, a, b, 1]) returns [b, a, 1]
+//
+// @ExprPattern (_(___))
+func DeleteDuplicates(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	list := args[0].(core.List)
+	seen := core.NewExprSet()
+	result := make([]core.Expr, 0, list.Length())
+	for _, elem := range list.Tail() {
+		if seen.Add(elem) {
+			result = append(result, elem)
+		}
+	}
+	return core.ListFrom(list.Head(), result...)
+}
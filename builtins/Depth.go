@@ -0,0 +1,17 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Depth
+// @ExprAttributes Protected
+
+// Depth is the number of levels of nesting plus one, so atoms have
+// depth 1 and [a, [b]] has depth 3.  Heads are not counted.
+//
+// @ExprPattern (_)
+func Depth(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return core.NewInteger(core.Depth(args[0]))
+}
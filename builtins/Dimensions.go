@@ -0,0 +1,41 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Dimensions
+// @ExprAttributes Protected
+
+// Dimensions gives the lengths of each level of a rectangular nested
+// expression: Dimensions([[1, 2, 3], [4, 5, 6]]) is [2, 3].  It stops at
+// the first level where the lengths or heads differ, and is [] for atoms.
+//
+// @ExprPattern (_)
+func Dimensions(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	top, ok := args[0].(core.List)
+	if !ok {
+		return core.NewList(symbol.List)
+	}
+	head := top.Head()
+
+	var dims []core.Expr
+	level := []core.Expr{top}
+	for len(level) > 0 {
+		length := int64(-1)
+		var next []core.Expr
+		for _, expr := range level {
+			list, ok := expr.(core.List)
+			if !ok || !list.Head().Equal(head) || (length != -1 && list.Length() != length) {
+				return core.ListFrom(symbol.List, dims...)
+			}
+			length = list.Length()
+			next = append(next, list.Tail()...)
+		}
+		dims = append(dims, core.NewInteger(length))
+		level = next
+	}
+	return core.ListFrom(symbol.List, dims...)
+}
@@ -0,0 +1,25 @@
+package builtins
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol DirectoryName
+// @ExprAttributes Protected
+
+// DirectoryName returns the directory part of a file name:
+// DirectoryName("a/b/c.txt") returns "a/b", and DirectoryName("c.txt")
+// returns "".  Only the string is examined, not the file system.
+//
+// @ExprPattern (_String)
+func DirectoryName(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	name, _ := core.ExtractString(args[0])
+	if !strings.ContainsRune(name, filepath.Separator) {
+		return core.NewString("")
+	}
+	return core.NewString(filepath.Dir(name))
+}
@@ -0,0 +1,26 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/big"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Divisible
+// @ExprAttributes Protected
+
+// Divisible checks if a is exactly divisible by b: Divisible(12, 4)
+//
+// @ExprPattern (_Integer, _Integer)
+func Divisible(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	a := args[0].(core.Integer)
+	b := args[1].(core.Integer)
+	if b.Sign() == 0 {
+		return core.NewError("DivisionByZero", "Divisible by zero")
+	}
+	if a.IsInt64() && b.IsInt64() {
+		return core.NewBool(a.Int64()%b.Int64() == 0)
+	}
+	r := new(big.Int).Rem(a.AsBigInt(), b.AsBigInt())
+	return core.NewBool(r.Sign() == 0)
+}
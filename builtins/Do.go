@@ -72,14 +72,17 @@ func doIterator(e *engine.Evaluator, c *engine.Context, expr core.Expr, iterSpec
 	}
 
 	current := start
-	const maxIterations = 10000 // Prevent infinite loops
+	limit := c.IterationLimit() // Prevent infinite loops
 
-	for iteration := 0; iteration < maxIterations; iteration++ {
+	for iteration := 0; ; iteration++ {
 		// Check if we should continue iterating
 		shouldContinue := evaluateIteratorCondition(e, c, current, end, increment)
 		if !shouldContinue {
 			break
 		}
+		if limit > 0 && iteration >= limit {
+			return core.NewError("IterationLimitError", fmt.Sprintf("iteration limit of %d exceeded", limit))
+		}
 
 		// Evaluate expression with current iterator value (for side effects only)
 		blockResult := evaluateWithIteratorBinding(e, c, expr, variable, current)
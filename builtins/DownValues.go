@@ -0,0 +1,41 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol DownValues
+// @ExprAttributes HoldAll Protected
+
+// DownValues returns the definitions of a symbol as a list of rules, in
+// the order they are tried:
+// f(0) := 1; f(n_) := n * f(n - 1); DownValues(f)
+// [HoldPattern(f(0)) => 1, HoldPattern(f(n_)) => n * f(n - 1)]
+// The definitions can be replaced with DownValues(f) = rules.
+//
+// @ExprPattern (_Symbol)
+func DownValues(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	rules := c.GetFunctionRegistry().DownValues(args[0].(core.Symbol))
+	return core.ListFrom(symbol.List, rules...)
+}
+
+// setDownValues implements DownValues(sym) = rules
+func setDownValues(c *engine.Context, lhs core.List, rules core.Expr) core.Expr {
+	sym, ok := lhs.Tail()[0].(core.Symbol)
+	if !ok {
+		return core.NewError("ArgumentError", "DownValues expects a symbol")
+	}
+	if c.GetSymbolTable().HasAttribute(sym, engine.Protected) {
+		return core.NewError("Protected", "symbol "+sym.String()+" is Protected")
+	}
+	list, ok := rules.(core.List)
+	if !ok || list.Head() != symbol.List {
+		return core.NewError("ArgumentError", "DownValues must be set to a list of rules")
+	}
+	if err := c.GetFunctionRegistry().SetDownValues(sym, list.Tail()); err != nil {
+		return core.NewError("ArgumentError", err.Error())
+	}
+	return rules
+}
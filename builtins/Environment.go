@@ -0,0 +1,29 @@
+package builtins
+
+import (
+	"os"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Environment
+// @ExprAttributes Protected
+
+// Environment returns the value of an environment variable as a string:
+// Environment("HOME") returns Missing("NotFound") if it is not set.
+// It is disabled in safe mode.
+//
+// @ExprPattern (_String)
+func Environment(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if e.SafeMode() {
+		return core.NewError("SecurityError", "Environment is disabled in safe mode")
+	}
+	name, _ := core.ExtractString(args[0])
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return core.ListFrom(symbol.Missing, core.NewString("NotFound"))
+	}
+	return core.NewString(value)
+}
@@ -2,49 +2,69 @@ package builtins
 
 import (
 	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
 	"github.com/client9/cardinal/engine"
 )
 
 // @ExprSymbol Equal
 
-// @ExprPattern (_Integer, _Real)
-func EqualInt64Float64(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x := float64(core.MustInt64(args[0]))
-	y := core.MustFloat64(args[1])
-	return core.NewBool(x == y)
-}
-
-// @ExprPattern (_Real, _Integer)
-func EqualFloat64Int64(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x := core.MustFloat64(args[0])
-	y := float64(core.MustInt64(args[1]))
-	return core.NewBool(x == y)
-}
-
+// Equal is mathematical equality.  Unlike SameQ, numbers are compared
+// by value so 1 == 1.0 is True.  When equality can not be decided, such
+// as with symbols x == y, Equal stays unevaluated.
+//
 // @ExprPattern (_,_)
 func Equal(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x := args[0]
-	y := args[1]
-	return core.NewBool(x.Equal(y))
+	eq, ok := equalQ(args[0], args[1])
+	if !ok {
+		return core.ListFrom(symbol.Equal, args...)
+	}
+	return core.NewBool(eq)
 }
 
-/*
-// @ExprPattern (_Integer, _Integer)
-func EqualInts(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x := core.MustInt64(args[0])
-	y := core.MustInt64(args[1])
-	return core.NewBool(x == y)
-}
-// @ExprPattern (_Real, _Real)
-func EqualFloat64(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x := core.MustFloat64(args[0])
-	y := core.MustFloat64(args[1])
-	return core.NewBool(x == y)
+// equalQ compares x and y by value.  The second result is false if
+// equality can not be decided.
+func equalQ(x, y core.Expr) (bool, bool) {
+	if x.Equal(y) {
+		return true, true
+	}
+	switch xv := x.(type) {
+	case core.Number:
+		switch yv := y.(type) {
+		case core.Number:
+			return core.CompareNumbers(xv, yv) == 0, true
+		case core.String:
+			return false, true
+		}
+	case core.String:
+		switch y.(type) {
+		case core.Number, core.String:
+			return false, true
+		}
+	case core.List:
+		yv, ok := y.(core.List)
+		if !ok || xv.Head() != symbol.List || yv.Head() != symbol.List {
+			break
+		}
+		if xv.Length() != yv.Length() {
+			return false, true
+		}
+		decided := true
+		ys := yv.Tail()
+		for i, xe := range xv.Tail() {
+			eq, ok := equalQ(xe, ys[i])
+			if ok && !eq {
+				return false, true
+			}
+			decided = decided && ok
+		}
+		return decided, decided
+	}
+	if isBoolSymbol(x) && isBoolSymbol(y) {
+		return false, true
+	}
+	return false, false
 }
-// @ExprPattern (_String, _String)
-func EqualString(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x, _ := core.ExtractString(args[0])
-	y, _ := core.ExtractString(args[1])
-	return core.NewBool(x == y)
+
+func isBoolSymbol(x core.Expr) bool {
+	return x == symbol.True || x == symbol.False
 }
-*/
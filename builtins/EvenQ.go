@@ -0,0 +1,30 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol EvenQ
+// @ExprAttributes Protected
+
+// EvenQ checks if an integer is even
+//
+// @ExprPattern (_Integer)
+func EvenQ(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return core.NewBool(!isOdd(args[0].(core.Integer)))
+}
+
+// EvenQOther is False for anything that is not an integer
+//
+// @ExprPattern (_)
+func EvenQOther(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return core.NewBool(false)
+}
+
+func isOdd(n core.Integer) bool {
+	if n.IsInt64() {
+		return n.Int64()&1 != 0
+	}
+	return n.AsBigInt().Bit(0) == 1
+}
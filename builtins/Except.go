@@ -3,4 +3,5 @@ package builtins
 // @ExprSymbol Except
 // @ExprAttributes Protected
 //
-//
+// Except(c) matches anything that does not match c, and Except(c, p)
+// matches anything that matches p but not c.
@@ -0,0 +1,37 @@
+package builtins
+
+import (
+	"math"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/big"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Exp
+// @ExprAttributes Listable NumericFunction Protected
+
+// @ExprPattern (_Real)
+func ExpReal(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	r := args[0].(core.Real)
+	if r.Prec() <= 53 {
+		return core.NewReal(math.Exp(r.Float64()))
+	}
+	return new(big.Float).SetPrec(r.Prec()).Exp(r.AsBigFloat())
+}
+
+// Exp is symbolically converted to Power(E, x), with Exp(0) being 1
+//
+// @ExprPattern (_)
+func Exp(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if i, ok := args[0].(core.Integer); ok {
+		switch {
+		case i.Sign() == 0:
+			return core.NewInteger(1)
+		case i.IsInt64() && i.Int64() == 1:
+			return symbol.E
+		}
+	}
+	return core.ListFrom(symbol.Power, symbol.E, args[0])
+}
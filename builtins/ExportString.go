@@ -0,0 +1,24 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol ExportString
+// @ExprAttributes Protected
+
+// ExportString converts an expression to text in a format.
+// The only format is "JSON", see core.ToJSON.
+// @ExprPattern (_, _String)
+func ExportString(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	format, _ := core.ExtractString(args[1])
+	if format != "JSON" {
+		return core.NewError("ExportError", "unknown export format "+args[1].InputForm())
+	}
+	data, err := core.ToJSON(args[0])
+	if err != nil {
+		return core.NewError("ExportError", err.Error())
+	}
+	return core.NewString(string(data))
+}
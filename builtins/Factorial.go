@@ -0,0 +1,25 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/big"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Factorial
+// @ExprAttributes Listable NumericFunction Protected
+
+// Factorial is n!, promoting to a big integer when it no longer fits
+// in a machine integer.  Negative arguments are an error.
+//
+// @ExprPattern (_Integer)
+func Factorial(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	n := args[0].(core.Integer)
+	if n.Sign() < 0 {
+		return core.NewError("ArgumentError", "Factorial is not defined for negative integers")
+	}
+	if !n.IsInt64() {
+		return core.NewError("ArgumentError", "Factorial argument is too large")
+	}
+	return normalizeBigInt(big.NewInt(1).MulRange(1, n.Int64()))
+}
@@ -0,0 +1,51 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/big"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Fibonacci
+// @ExprAttributes Listable NumericFunction Protected
+
+// Fibonacci is the n-th Fibonacci number, with Fibonacci(0) = 0 and
+// Fibonacci(1) = 1.  Negative n use Fibonacci(-n) = (-1)^(n+1) Fibonacci(n).
+//
+// @ExprPattern (_Integer)
+func Fibonacci(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	n := args[0].(core.Integer)
+	if !n.IsInt64() {
+		return core.NewError("ArgumentError", "Fibonacci argument is too large")
+	}
+	v := n.Int64()
+	neg := v < 0
+	if neg {
+		v = -v
+	}
+	f, _ := fibonacciPair(v)
+	if neg && v%2 == 0 {
+		f.Neg(f)
+	}
+	return normalizeBigInt(f)
+}
+
+// fibonacciPair returns F(n) and F(n+1) using fast doubling:
+//
+//	F(2k)   = F(k) * (2*F(k+1) - F(k))
+//	F(2k+1) = F(k)^2 + F(k+1)^2
+func fibonacciPair(n int64) (*big.Int, *big.Int) {
+	if n == 0 {
+		return big.NewInt(0), big.NewInt(1)
+	}
+	a, b := fibonacciPair(n / 2)
+	t := new(big.Int).Lsh(b, 1)
+	t.Sub(t, a)
+	c := new(big.Int).Mul(a, t)
+	d := new(big.Int).Mul(a, a)
+	d.Add(d, new(big.Int).Mul(b, b))
+	if n%2 == 0 {
+		return c, d
+	}
+	return d, c.Add(c, d)
+}
@@ -0,0 +1,29 @@
+package builtins
+
+import (
+	"path/filepath"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol FileNameJoin
+// @ExprAttributes Protected
+
+// FileNameJoin joins a list of names into a file name:
+// FileNameJoin(["a", "b", "c.txt"]) returns "a/b/c.txt"
+// Only the strings are examined, not the file system.
+//
+// @ExprPattern (_List)
+func FileNameJoin(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	list := args[0].(core.List)
+	parts := make([]string, 0, list.Length())
+	for _, arg := range list.Tail() {
+		s, ok := core.ExtractString(arg)
+		if !ok {
+			return core.NewError("TypeError", "FileNameJoin expects a list of strings")
+		}
+		parts = append(parts, s)
+	}
+	return core.NewString(filepath.Join(parts...))
+}
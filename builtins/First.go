@@ -7,8 +7,23 @@ import (
 
 // @ExprSymbol First
 
-// FirstExpr returns the first element of a list (after the head)
+// FirstExpr returns the first element of a list or string.  An empty
+// expression is a PartError.
+//
 // @ExprPattern (_)
 func FirstExpr(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if core.IsSliceable(args[0]) && args[0].Length() == 0 {
+		return core.NewError("PartError", "First of an empty expression")
+	}
+	return core.First(args[0])
+}
+
+// FirstDefault returns def instead of an error for an empty expression
+//
+// @ExprPattern (_, _)
+func FirstDefault(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if core.IsSliceable(args[0]) && args[0].Length() == 0 {
+		return args[1]
+	}
 	return core.First(args[0])
 }
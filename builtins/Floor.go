@@ -21,16 +21,14 @@ func FloorInteger(e *engine.Evaluator, c *engine.Context, args []core.Expr) core
 func FloorReal(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	r := args[0].(core.Real)
 	if r.IsFloat64() {
-		return core.NewInteger(int64(math.Floor(r.Float64())))
+		return integerFromFloat64(math.Floor(r.Float64()))
 	}
 
 	// Big Real
 	return new(big.Float).Floor(r.AsBigFloat()).Int()
 }
 
-/*
 // @ExprPattern (_Rational)
 func FloorRational(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	return args[0].(core.Rational).AsNum()
+	return floorRat(args[0].(core.Rational).AsBigRat())
 }
-*/
@@ -0,0 +1,31 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol For
+// @ExprAttributes HoldAll Protected
+
+// For evaluates start, then body and incr while test is True, and
+// returns Null: For(i = 0, i < 10, i = i + 1, total = total + i)
+//
+// @ExprPattern (_, _, _, _)
+func For(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if start := e.Evaluate(args[0]); core.IsError(start) {
+		return start
+	}
+	return loop(e, c, args[1], args[2], args[3])
+}
+
+// ForNoBody is For(start, test, incr) with an empty body
+//
+// @ExprPattern (_, _, _)
+func ForNoBody(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if start := e.Evaluate(args[0]); core.IsError(start) {
+		return start
+	}
+	return loop(e, c, args[1], args[2], symbol.Null)
+}
@@ -20,7 +20,8 @@ func FractionalPart(e *engine.Evaluator, c *engine.Context, args []core.Expr) co
 
 // @ExprPattern (_Rational)
 func FractionalPartRational(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	return core.NewReal(math.Trunc(args[0].(core.Rational).Float64()))
+	f := args[0].(core.Rational).Float64()
+	return core.NewReal(f - math.Trunc(f))
 }
 
 // @ExprPattern (_Real)
@@ -31,7 +32,7 @@ func FractionalPartReal(e *engine.Evaluator, c *engine.Context, args []core.Expr
 		flt := args[0].(core.Real).Float64()
 		mantissa, exp := math.Frexp(flt)
 		if exp >= 52 {
-			return core.NewInteger(0)
+			return core.NewReal(0)
 		}
 		if exp >= 0 {
 			tmp := args[0].(core.Real).Float64()
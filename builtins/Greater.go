@@ -2,35 +2,16 @@ package builtins
 
 import (
 	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
 	"github.com/client9/cardinal/engine"
 )
 
-// @ExprSymbol GreaterEqual
+// @ExprSymbol Greater
 
-// @ExprPattern (_Integer, _Real)
-func GreaterEqualInt64Float64(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x := float64(core.MustInt64(args[0]))
-	y := core.MustFloat64(args[1])
-	return core.NewBool(x >= y)
-}
-
-// @ExprPattern (_Real, _Integer)
-func GreaterEqualFloat64Int64(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x := core.MustFloat64(args[0])
-	y := float64(core.MustInt64(args[1]))
-	return core.NewBool(x >= y)
-}
-
-// @ExprPattern (_Integer, _Integer)
-func GreaterEqualInts(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x := core.MustInt64(args[0])
-	y := core.MustInt64(args[1])
-	return core.NewBool(x >= y)
-}
-
-// @ExprPattern (_Real, _Real)
-func GreaterEqualFloat64(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x := core.MustFloat64(args[0])
-	y := core.MustFloat64(args[1])
-	return core.NewBool(x >= y)
+// Greater is True if each argument is greater than the next.
+// Non-numeric arguments leave it partially simplified.
+//
+// @ExprPattern (___)
+func Greater(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return compareChain(symbol.Greater, args, func(cmp int) bool { return cmp > 0 })
 }
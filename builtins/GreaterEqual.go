@@ -2,35 +2,16 @@ package builtins
 
 import (
 	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
 	"github.com/client9/cardinal/engine"
 )
 
-// @ExprSymbol Greater
+// @ExprSymbol GreaterEqual
 
-// @ExprPattern (_Integer, _Real)
-func GreaterInt64Float64(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x := float64(core.MustInt64(args[0]))
-	y := core.MustFloat64(args[1])
-	return core.NewBool(x > y)
-}
-
-// @ExprPattern (_Real, _Integer)
-func GreaterFloat64Int64(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x := core.MustFloat64(args[0])
-	y := float64(core.MustInt64(args[1]))
-	return core.NewBool(x > y)
-}
-
-// @ExprPattern (_Integer, _Integer)
-func GreaterInts(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x := core.MustInt64(args[0])
-	y := core.MustInt64(args[1])
-	return core.NewBool(x > y)
-}
-
-// @ExprPattern (_Real, _Real)
-func GreaterFloat64(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x := core.MustFloat64(args[0])
-	y := core.MustFloat64(args[1])
-	return core.NewBool(x > y)
+// GreaterEqual is True if each argument is greater than or equal to the next.
+// Non-numeric arguments leave it partially simplified.
+//
+// @ExprPattern (___)
+func GreaterEqual(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return compareChain(symbol.GreaterEqual, args, func(cmp int) bool { return cmp >= 0 })
 }
@@ -6,6 +6,7 @@ import (
 )
 
 // @ExprSymbol Head
+// @ExprAttributes Protected
 
 // Head returns the head/type of an expression
 // Head(Foo(1,2,3)) is Foo (e.g. Symbol(Foo))
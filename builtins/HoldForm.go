@@ -0,0 +1,7 @@
+package builtins
+
+// @ExprSymbol HoldForm
+// @ExprAttributes HoldAll Protected
+//
+// HoldForm(expr) keeps expr from being evaluated like Hold, but prints
+// as just expr: HoldForm(1 + 1) prints as Plus(1, 1).
@@ -0,0 +1,7 @@
+package builtins
+
+// @ExprSymbol HoldPattern
+// @ExprAttributes HoldAll Protected
+//
+// HoldPattern(p) keeps p from being evaluated, but matches the same
+// expressions as p.  HoldPattern(1 + 1) : x matches Plus(1, 1), not 2.
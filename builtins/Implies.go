@@ -0,0 +1,33 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Implies
+// @ExprAttributes HoldAll Protected
+
+// Implies is logical implication, p => q.  If p is False then q is
+// never evaluated.
+//
+// @ExprPattern (_, _)
+func Implies(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	p := e.Evaluate(args[0])
+	if val, ok := core.ExtractBool(p); ok {
+		if !val {
+			return core.NewBool(true)
+		}
+		return e.Evaluate(args[1])
+	}
+
+	q := e.Evaluate(args[1])
+	if val, ok := core.ExtractBool(q); ok {
+		if val {
+			return core.NewBool(true)
+		}
+		return core.ListFrom(symbol.Not, p)
+	}
+	return core.ListFrom(symbol.Implies, p, q)
+}
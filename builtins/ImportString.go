@@ -0,0 +1,25 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol ImportString
+// @ExprAttributes Protected
+
+// ImportString reads an expression from text in a format.
+// The only format is "JSON", see core.FromJSON.
+// @ExprPattern (_String, _String)
+func ImportString(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	s, _ := core.ExtractString(args[0])
+	format, _ := core.ExtractString(args[1])
+	if format != "JSON" {
+		return core.NewError("ImportError", "unknown import format "+args[1].InputForm())
+	}
+	expr, err := core.FromJSON([]byte(s))
+	if err != nil {
+		return core.NewError("ImportError", "malformed JSON: "+err.Error())
+	}
+	return expr
+}
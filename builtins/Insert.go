@@ -0,0 +1,21 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Insert
+
+// Insert puts x at position n of a List, so that it becomes element n.
+// Negative positions count from the end: Insert([a, b], x, -1) is
+// [a, b, x].  A position outside the list is a PartError.
+//
+// @ExprPattern (_List, _, _Integer)
+func Insert(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	n, ok := core.ExtractInt64(args[2])
+	if !ok {
+		return core.NewError("PartError", "Insert position is too large")
+	}
+	return args[0].(core.List).Insert(n, args[1])
+}
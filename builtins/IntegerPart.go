@@ -1,7 +1,6 @@
 package builtins
 
 import (
-	"fmt"
 	"math"
 
 	"github.com/client9/cardinal/core"
@@ -37,7 +36,6 @@ func IntegerPartReal(e *engine.Evaluator, c *engine.Context, args []core.Expr) c
 		// it's a big number
 		m := big.NewFloat(mantissa)
 		z := new(big.Float).SetMantExp(m, exp)
-		fmt.Println("PREC on Final IS ", z.Prec())
 		return z.Int()
 	}
 	flt := arg.AsBigFloat()
@@ -0,0 +1,42 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol KeyDrop
+// @ExprAttributes Protected
+
+// KeyDrop returns a new association without the given keys:
+// KeyDrop({"a": 1, "b": 2, "c": 3}, ["a", "c"]) is {"b": 2}
+// Keys that are not present are ignored.
+//
+// @ExprPattern (_Association, _List)
+func KeyDrop(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return keyDrop(args[0].(core.Association), args[1].(core.List).Tail())
+}
+
+// KeyDropSingle drops one key: KeyDrop(assoc, "a")
+//
+// @ExprPattern (_Association, _)
+func KeyDropSingle(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return keyDrop(args[0].(core.Association), args[1:])
+}
+
+func keyDrop(assoc core.Association, keys []core.Expr) core.Expr {
+	drop := core.NewAssociation()
+	for _, key := range keys {
+		drop = drop.Set(key, core.NewBool(true))
+	}
+
+	result := core.NewAssociation()
+	for _, key := range assoc.Keys() {
+		if _, ok := drop.Get(key); ok {
+			continue
+		}
+		value, _ := assoc.Get(key)
+		result = result.Set(key, value)
+	}
+	return result
+}
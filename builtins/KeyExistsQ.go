@@ -0,0 +1,18 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol KeyExistsQ
+// @ExprAttributes Protected
+
+// KeyExistsQ reports whether key is in the association:
+// KeyExistsQ({"a": 1}, "a") is True
+//
+// @ExprPattern (_Association, _)
+func KeyExistsQ(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	_, ok := args[0].(core.Association).Get(args[1])
+	return core.NewBool(ok)
+}
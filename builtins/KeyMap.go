@@ -0,0 +1,78 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol KeyMap
+
+// KeyMap applies f to every key of an association: KeyMap(f, assoc)
+//
+// If two keys map to the same new key, the value seen last wins and the
+// entry keeps the position of the first key.
+//
+// @ExprPattern (_, _Association)
+func KeyMap(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	fn := args[0]
+	assoc := args[1].(core.Association)
+
+	result := core.NewAssociation()
+	for _, key := range assoc.Keys() {
+		newKey := e.Evaluate(core.ListFrom(fn, key))
+		if core.IsError(newKey) {
+			return newKey
+		}
+		value, _ := assoc.Get(key)
+		result = result.Set(newKey, value)
+	}
+	return result
+}
+
+// KeyMapCombine applies f to every key of an association, resolving
+// collisions with a combiner: KeyMap(f, assoc, combiner)
+//
+// Values for colliding keys are gathered in order into a List and passed to
+// combiner, e.g. KeyMap(f, assoc, Length) counts them. Keys that do not collide
+// keep their value unchanged.
+//
+// @ExprPattern (_, _Association, _)
+func KeyMapCombine(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	fn := args[0]
+	assoc := args[1].(core.Association)
+	combiner := args[2]
+
+	// group values by new key, preserving first-seen order
+	var order []core.Expr
+	groups := core.NewAssociation()
+	for _, key := range assoc.Keys() {
+		newKey := e.Evaluate(core.ListFrom(fn, key))
+		if core.IsError(newKey) {
+			return newKey
+		}
+		value, _ := assoc.Get(key)
+		if existing, ok := groups.Get(newKey); ok {
+			groups = groups.Set(newKey, core.ListFrom(symbol.List, append(existing.(core.List).Tail(), value)...))
+			continue
+		}
+		order = append(order, newKey)
+		groups = groups.Set(newKey, core.ListFrom(symbol.List, value))
+	}
+
+	result := core.NewAssociation()
+	for _, key := range order {
+		group, _ := groups.Get(key)
+		values := group.(core.List)
+		if values.Length() == 1 {
+			result = result.Set(key, values.Tail()[0])
+			continue
+		}
+		combined := e.Evaluate(core.ListFrom(combiner, values))
+		if core.IsError(combined) {
+			return combined
+		}
+		result = result.Set(key, combined)
+	}
+	return result
+}
@@ -0,0 +1,29 @@
+package builtins
+
+import (
+	"sort"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol KeySort
+// @ExprAttributes Protected
+
+// KeySort returns the association with its keys in canonical order, the
+// same order used by Sort
+// @ExprPattern (_Association)
+func KeySort(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	assoc := args[0].(core.Association)
+	keys := assoc.Keys()
+	sort.SliceStable(keys, func(i, j int) bool {
+		return core.CanonicalCompare(keys[i], keys[j])
+	})
+
+	result := core.NewAssociation()
+	for _, key := range keys {
+		value, _ := assoc.Get(key)
+		result = result.Set(key, value)
+	}
+	return result
+}
@@ -7,8 +7,23 @@ import (
 
 // @ExprSymbol Last
 
-// LastExpr returns the last element of a list
+// LastExpr returns the last element of a list or string.  An empty
+// expression is a PartError.
+//
 // @ExprPattern (_)
 func LastExpr(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if core.IsSliceable(args[0]) && args[0].Length() == 0 {
+		return core.NewError("PartError", "Last of an empty expression")
+	}
+	return core.Last(args[0])
+}
+
+// LastDefault returns def instead of an error for an empty expression
+//
+// @ExprPattern (_, _)
+func LastDefault(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if core.IsSliceable(args[0]) && args[0].Length() == 0 {
+		return args[1]
+	}
 	return core.Last(args[0])
 }
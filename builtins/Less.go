@@ -2,35 +2,55 @@ package builtins
 
 import (
 	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
 	"github.com/client9/cardinal/engine"
 )
 
 // @ExprSymbol Less
 
-// @ExprPattern (_Integer, _Real)
-func LessInt64Float64(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x := float64(core.MustInt64(args[0]))
-	y := core.MustFloat64(args[1])
-	return core.NewBool(x < y)
+// Less is True if each argument is less than the next: Less(1, 2, 3) is
+// True.  Non-numeric arguments leave it partially simplified.
+//
+// @ExprPattern (___)
+func Less(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return compareChain(symbol.Less, args, func(cmp int) bool { return cmp < 0 })
 }
 
-// @ExprPattern (_Real, _Integer)
-func LessFloat64Int64(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x := core.MustFloat64(args[0])
-	y := float64(core.MustInt64(args[1]))
-	return core.NewBool(x < y)
-}
-
-// @ExprPattern (_Integer, _Integer)
-func LessInts(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x := core.MustInt64(args[0])
-	y := core.MustInt64(args[1])
-	return core.NewBool(x < y)
-}
+// compareChain checks that holds is true for every adjacent pair of
+// arguments, compared with core.CompareNumbers.
+//
+// If an adjacent pair of numbers fails, the result is False.  Otherwise
+// numbers that are already known to be in order are dropped, keeping
+// only those next to a non-numeric argument: Less(1, 2, x, 5, 6) is
+// Less(2, x, 5).
+func compareChain(head core.Expr, args []core.Expr, holds func(int) bool) core.Expr {
+	if len(args) < 2 {
+		return core.NewBool(true)
+	}
+	for i := 1; i < len(args); i++ {
+		x, xok := args[i-1].(core.Number)
+		y, yok := args[i].(core.Number)
+		if xok && yok && !holds(core.CompareNumbers(x, y)) {
+			return core.NewBool(false)
+		}
+	}
 
-// @ExprPattern (_Real, _Real)
-func LessFloat64(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	x := core.MustFloat64(args[0])
-	y := core.MustFloat64(args[1])
-	return core.NewBool(x < y)
+	isNumber := func(i int) bool {
+		if i < 0 || i >= len(args) {
+			return true
+		}
+		_, ok := args[i].(core.Number)
+		return ok
+	}
+	var keep []core.Expr
+	for i, arg := range args {
+		if isNumber(i) && isNumber(i-1) && isNumber(i+1) {
+			continue
+		}
+		keep = append(keep, arg)
+	}
+	if len(keep) == 0 {
+		return core.NewBool(true)
+	}
+	return core.ListFrom(head, keep...)
 }
@@ -0,0 +1,28 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Level
+// @ExprAttributes Protected
+
+// Level gives a List of the parts of expr at the given level spec,
+// deepest parts first: Level([[a, b], c], 2) is [a, b, [a, b], c].
+// Negative levels count from the bottom, so Level(expr, [-1]) is the
+// atoms of expr.
+//
+// @ExprPattern (_, _)
+func Level(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	spec, ok := core.ParseLevelSpec(args[1])
+	if !ok {
+		return core.NewError("ArgumentError", "Level spec must be n, Infinity, [n] or [min, max]")
+	}
+	var parts []core.Expr
+	core.WalkLevels(args[0], spec, func(part core.Expr, pos []int64) {
+		parts = append(parts, part)
+	})
+	return core.ListFrom(symbol.List, parts...)
+}
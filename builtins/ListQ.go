@@ -2,10 +2,16 @@ package builtins
 
 import (
 	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
 )
 
-// ListQ checks if an expression is a list
-func ListQ(expr core.Expr) core.Expr {
-	_, ok := expr.(core.List)
-	return core.NewBool(ok)
+// @ExprSymbol ListQ
+// @ExprAttributes Protected
+
+// ListQ checks if an expression is a list.  Other compound
+// expressions such as f(x) are not lists.
+//
+// @ExprPattern (_)
+func ListQ(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return core.NewBool(core.MatchesType(args[0], "List"))
 }
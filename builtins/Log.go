@@ -4,21 +4,109 @@ import (
 	"math"
 
 	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/big"
+	"github.com/client9/cardinal/core/symbol"
 	"github.com/client9/cardinal/engine"
 )
 
 // @ExprSymbol Log
+// @ExprAttributes Listable NumericFunction Protected
 
+// LogReal is the natural logarithm.  Negative numbers stay
+// unevaluated since complex numbers are not supported.
+//
 // @ExprPattern (_Real)
+func LogReal(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	r := args[0].(core.Real)
+	if r.Sign() < 0 {
+		return core.ListFrom(symbol.Log, args[0])
+	}
+	// HACK: no arbitrary precision logarithm yet
+	return core.NewReal(math.Log(r.Float64()))
+}
+
+// Log gives exact values for Log(1) and Log(E^n), otherwise it stays
+// unevaluated.  Use N for a numeric value.
+//
+// @ExprPattern (_)
 func Log(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	// HACK
-	r := args[0].(core.Real).Float64()
-	return core.NewReal(math.Log(r))
+	x := args[0]
+	if isOne(x) {
+		return core.NewInteger(0)
+	}
+	if x == symbol.E {
+		return core.NewInteger(1)
+	}
+	if p, ok := x.(core.List); ok && p.Head() == symbol.Power && p.Length() == 2 && p.Tail()[0] == symbol.E {
+		switch p.Tail()[1].(type) {
+		case core.Integer, core.Rational:
+			return p.Tail()[1]
+		}
+	}
+	return core.ListFrom(symbol.Log, x)
+}
+
+// LogBase is the logarithm of x to base b.  It is exact when x is an
+// integer power of b, numeric when either argument is a Real, and
+// otherwise stays unevaluated.
+//
+// @ExprPattern (_, _)
+func LogBase(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	b, x := args[0], args[1]
+	if isOne(x) {
+		return core.NewInteger(0)
+	}
+	if b.Equal(x) {
+		return core.NewInteger(1)
+	}
+	bn, bok := b.(core.Number)
+	xn, xok := x.(core.Number)
+	if !bok || !xok || bn.Sign() <= 0 || xn.Sign() <= 0 {
+		return core.ListFrom(symbol.Log, b, x)
+	}
+	_, breal := b.(core.Real)
+	_, xreal := x.(core.Real)
+	if breal || xreal {
+		switch bn.Float64() {
+		case 2:
+			return core.NewReal(math.Log2(xn.Float64()))
+		case 10:
+			return core.NewReal(math.Log10(xn.Float64()))
+		}
+		return core.NewReal(math.Log(xn.Float64()) / math.Log(bn.Float64()))
+	}
+	if bi, ok := b.(core.Integer); ok {
+		if xi, ok := x.(core.Integer); ok {
+			if n, ok := integerLog(bi.AsBigInt(), xi.AsBigInt()); ok {
+				return core.NewInteger(n)
+			}
+		}
+	}
+	return core.ListFrom(symbol.Log, b, x)
+}
+
+func isOne(x core.Expr) bool {
+	i, ok := x.(core.Integer)
+	return ok && i.IsInt64() && i.Int64() == 1
 }
 
-// @ExprPattern (_Real, 2)
-func Log2(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	// HACK
-	r := args[0].(core.Real).Float64()
-	return core.NewReal(math.Log2(r))
+// integerLog returns n such that b^n == x, for b > 1 and x > 1
+func integerLog(b, x *big.Int) (int64, bool) {
+	one := big.NewInt(1)
+	if b.Cmp(one) <= 0 || x.Cmp(one) <= 0 {
+		return 0, false
+	}
+	r := new(big.Int).Set(x)
+	q := new(big.Int)
+	rem := new(big.Int)
+	n := int64(0)
+	for r.Cmp(one) != 0 {
+		q.QuoRem(r, b, rem)
+		if rem.Sign() != 0 {
+			return 0, false
+		}
+		r.Set(q)
+		n++
+	}
+	return n, true
 }
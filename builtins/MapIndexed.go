@@ -0,0 +1,41 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol MapIndexed
+// @ExprAttributes Protected
+
+// MapIndexed applies f to each element along with its position, as
+// a List of 1-based indices: MapIndexed(f, [a, b]) is
+// [f(a, [1]), f(b, [2])]
+//
+// @ExprPattern (_, _)
+func MapIndexed(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return mapIndexed(e, args[0], args[1], core.LevelSpec{Min: 1, Max: 1})
+}
+
+// MapIndexedLevel applies f to the parts at the given level spec,
+// deepest parts first.  The index is the full position of the part.
+//
+// @ExprPattern (_, _, _)
+func MapIndexedLevel(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	spec, ok := core.ParseLevelSpec(args[2])
+	if !ok {
+		return core.NewError("ArgumentError", "MapIndexed level must be n, Infinity, [n] or [min, max]")
+	}
+	return mapIndexed(e, args[0], args[1], spec)
+}
+
+func mapIndexed(e *engine.Evaluator, fn core.Expr, expr core.Expr, spec core.LevelSpec) core.Expr {
+	return core.MapLevels(expr, spec, func(part core.Expr, pos []int64) core.Expr {
+		index := make([]core.Expr, len(pos))
+		for i, p := range pos {
+			index[i] = core.NewInteger(p)
+		}
+		return e.Evaluate(core.ListFrom(fn, part, core.ListFrom(symbol.List, index...)))
+	})
+}
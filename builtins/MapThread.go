@@ -0,0 +1,69 @@
+package builtins
+
+import (
+	"fmt"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol MapThread
+// @ExprAttributes Protected
+
+// MapThread applies f to corresponding elements of several lists:
+// MapThread(f, [[a, b], [x, y]]) is [f(a, x), f(b, y)]
+//
+// @ExprPattern (_, _List)
+func MapThread(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return mapThread(e, args[0], args[1].(core.List).Tail(), 1)
+}
+
+// MapThreadLevel threads through the first n levels of the lists
+//
+// @ExprPattern (_, _List, _Integer)
+func MapThreadLevel(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	n := args[2].(core.Integer)
+	if n.Sign() < 0 || !n.IsInt64() {
+		return core.NewError("ArgumentError", "MapThread level must be a non-negative integer")
+	}
+	return mapThread(e, args[0], args[1].(core.List).Tail(), n.Int64())
+}
+
+func mapThread(e *engine.Evaluator, fn core.Expr, lists []core.Expr, level int64) core.Expr {
+	if level == 0 {
+		return e.Evaluate(core.ListFrom(fn, lists...))
+	}
+	if len(lists) == 0 {
+		return core.NewList(symbol.List)
+	}
+
+	var head core.Expr
+	length := int64(-1)
+	for _, arg := range lists {
+		list, ok := arg.(core.List)
+		if !ok {
+			return core.NewError("ArgumentError", fmt.Sprintf("MapThread expected a list, got %s", arg))
+		}
+		if length == -1 {
+			head = list.Head()
+			length = list.Length()
+		} else if list.Length() != length {
+			return core.NewError("LengthMismatch",
+				fmt.Sprintf("MapThread lists have lengths %d and %d", length, list.Length()))
+		}
+	}
+
+	result := make([]core.Expr, length)
+	parts := make([]core.Expr, len(lists))
+	for i := range result {
+		for j, arg := range lists {
+			parts[j] = arg.(core.List).Tail()[i]
+		}
+		result[i] = mapThread(e, fn, parts, level-1)
+		if core.IsError(result[i]) {
+			return result[i]
+		}
+	}
+	return core.ListFrom(head, result...)
+}
@@ -2,17 +2,27 @@ package builtins
 
 import (
 	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
 	"github.com/client9/cardinal/engine"
 )
 
 // @ExprSymbol MatchQ
 
-// MatchQExprs checks if an expression matches a pattern (pure test, no variable binding)
+// MatchQ checks if an expression matches a pattern, including any
+// Condition or PatternTest
 // @ExprPattern (_,_)
 func MatchQ(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	expr := args[0]
 	pattern := args[1]
 
-	ok, _ := core.MatchWithBindings(expr, pattern)
+	ok, _ := e.Match(expr, pattern)
 	return core.NewBool(ok)
 }
+
+// MatchQOperator is the operator form MatchQ(pattern), which returns a
+// function that tests its argument: Select(list, MatchQ(_Integer))
+//
+// @ExprPattern (_)
+func MatchQOperator(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return core.NewFunction(nil, core.ListFrom(symbol.MatchQ, core.NewSymbol("$1"), args[0]))
+}
@@ -0,0 +1,32 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Max
+// @ExprAttributes Protected
+
+// Max returns the largest of its numeric arguments, unchanged:
+// Max(1, 2.5, 2) is 2.5
+// If any argument is not a number, Max stays unevaluated.
+//
+// @ExprPattern (__)
+func Max(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if m, ok := extremeNumber(args, 1); ok {
+		return m
+	}
+	return core.NewList(symbol.Max, args...)
+}
+
+// MaxList returns the largest element of a list: Max([3, 1, 2])
+//
+// @ExprPattern (_List)
+func MaxList(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if m, ok := extremeNumber(args[0].(core.List).Tail(), 1); ok {
+		return m
+	}
+	return core.NewList(symbol.Max, args...)
+}
@@ -0,0 +1,54 @@
+package builtins
+
+import (
+	"fmt"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Merge
+// @ExprAttributes Protected
+
+// Merge combines a list of associations, applying f to the List of values
+// collected for each key: Merge([{a: 1}, {a: 2, b: 3}], Total)
+// Keys appear in the order they are first seen.  Unlike KeyMap, f is
+// applied even when a key has a single value.
+//
+// @ExprPattern (_List, _)
+func Merge(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	assocs := args[0].(core.List)
+	combiner := args[1]
+
+	// group values by key, preserving first-seen order
+	var order []core.Expr
+	groups := core.NewAssociation()
+	for _, arg := range assocs.Tail() {
+		assoc, ok := arg.(core.Association)
+		if !ok {
+			return core.NewError("TypeError",
+				fmt.Sprintf("Merge expected a list of associations, got %s", arg.String()))
+		}
+		for _, key := range assoc.Keys() {
+			value, _ := assoc.Get(key)
+			if existing, ok := groups.Get(key); ok {
+				groups = groups.Set(key, core.ListFrom(symbol.List, append(existing.(core.List).Tail(), value)...))
+				continue
+			}
+			order = append(order, key)
+			groups = groups.Set(key, core.ListFrom(symbol.List, value))
+		}
+	}
+
+	result := core.NewAssociation()
+	for _, key := range order {
+		values, _ := groups.Get(key)
+		combined := e.Evaluate(core.ListFrom(combiner, values))
+		if core.IsError(combined) {
+			return combined
+		}
+		result = result.Set(key, combined)
+	}
+	return result
+}
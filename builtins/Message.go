@@ -0,0 +1,51 @@
+package builtins
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Message
+// @ExprAttributes Protected
+
+// Message records a diagnostic in the Context without stopping
+// evaluation, and returns Null.  The REPL prints it after the result
+// is computed.  A String tag is a template where `1`, `2`, ... are
+// replaced by the arguments: Message("x is `1`", 5) is "x is 5".
+// Any other tag is followed by the arguments: Message(f, 1, 2) is
+// "f: 1, 2".
+//
+// @ExprPattern (_, ___)
+func Message(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	c.AddMessage(formatMessage(args[0], args[1:]))
+	return symbol.Null
+}
+
+func formatMessage(tag core.Expr, args []core.Expr) string {
+	text := make([]string, len(args))
+	for i, arg := range args {
+		// strings are shown without quotes, as with ToString
+		if s, ok := arg.(core.String); ok {
+			text[i] = string(s)
+		} else {
+			text[i] = arg.String()
+		}
+	}
+
+	template, ok := tag.(core.String)
+	if !ok {
+		if len(text) == 0 {
+			return tag.String()
+		}
+		return tag.String() + ": " + strings.Join(text, ", ")
+	}
+	msg := string(template)
+	for i := len(text); i > 0; i-- {
+		msg = strings.ReplaceAll(msg, "`"+strconv.Itoa(i)+"`", text[i-1])
+	}
+	return msg
+}
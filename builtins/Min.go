@@ -0,0 +1,55 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Min
+// @ExprAttributes Protected
+
+// Min returns the smallest of its numeric arguments, unchanged:
+// Min(3, 1.5, 2) is 1.5
+// If any argument is not a number, Min stays unevaluated.
+//
+// @ExprPattern (__)
+func Min(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if m, ok := extremeNumber(args, -1); ok {
+		return m
+	}
+	return core.NewList(symbol.Min, args...)
+}
+
+// MinList returns the smallest element of a list: Min([3, 1, 2])
+//
+// @ExprPattern (_List)
+func MinList(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if m, ok := extremeNumber(args[0].(core.List).Tail(), -1); ok {
+		return m
+	}
+	return core.NewList(symbol.Min, args...)
+}
+
+// extremeNumber returns the first number x such that comparing it against
+// every other argument never gives -want, i.e. the minimum for -1 and the
+// maximum for +1.  It fails if the list is empty or contains a non-number.
+func extremeNumber(args []core.Expr, want int) (core.Expr, bool) {
+	if len(args) == 0 {
+		return nil, false
+	}
+	best, ok := args[0].(core.Number)
+	if !ok {
+		return nil, false
+	}
+	for _, arg := range args[1:] {
+		n, ok := arg.(core.Number)
+		if !ok {
+			return nil, false
+		}
+		if core.CompareNumbers(n, best) == want {
+			best = n
+		}
+	}
+	return best, true
+}
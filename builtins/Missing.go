@@ -0,0 +1,4 @@
+package builtins
+
+// @ExprSymbol Missing
+// @ExprAttributes Protected
@@ -0,0 +1,106 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Module
+// @ExprAttributes HoldAll
+
+// Module evaluates body with lexically scoped local variables:
+// Module([x, y = 1], body)
+// Each local is renamed to a fresh symbol such as x$12 throughout body,
+// so it can not clash with an outer x or with another call of the same
+// Module.  The fresh symbols are cleared when the Module returns, unless
+// the result still refers to them, as a returned Function may, or a
+// value or definition made by body does, as g(y_) := y + x.
+//
+// @ExprPattern (_List, _)
+func Module(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	vars := args[0].(core.List)
+	body := args[1]
+
+	locals := make([]core.Symbol, 0, vars.Length())
+	rules := make([]core.Expr, 0, vars.Length())
+	values := make([]core.Expr, 0, vars.Length())
+	for _, arg := range vars.Tail() {
+		name, value := arg, core.Expr(nil)
+		if arg.Head() == symbol.Set && arg.Length() == 2 {
+			setArgs := arg.(core.List).Tail()
+			name, value = setArgs[0], setArgs[1]
+		}
+		sym, ok := name.(core.Symbol)
+		if !ok {
+			return core.NewError("ArgumentError", "Module variables must be symbols or assignments to symbols")
+		}
+		local := e.UniqueSymbol(sym.String())
+		locals = append(locals, local)
+		rules = append(rules, core.ListFrom(symbol.Rule, sym, local))
+		values = append(values, value)
+	}
+
+	// initial values are evaluated outside of the Module's scope
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+		v := e.Evaluate(value)
+		if core.IsError(v) {
+			return v
+		}
+		c.Set(locals[i], v)
+	}
+
+	version := c.StateVersion()
+	result := e.Evaluate(core.ReplaceAllWithRules(body, core.ListFrom(symbol.List, rules...)))
+
+	escaped := make(map[core.Symbol]bool)
+	referencedLocals(result, locals, escaped)
+	if c.StateVersion() != version {
+		// only the values and definitions of the locals themselves go away
+		isLocal := make(map[core.Symbol]bool, len(locals))
+		for _, local := range locals {
+			isLocal[local] = true
+		}
+		c.WalkState(func(name core.Symbol, expr core.Expr) {
+			if !isLocal[name] {
+				referencedLocals(expr, locals, escaped)
+			}
+		})
+	}
+	for _, local := range locals {
+		if !escaped[local] {
+			c.Clear(local)
+		}
+	}
+	return result
+}
+
+// referencedLocals adds the locals that appear anywhere in expr to found
+func referencedLocals(expr core.Expr, locals []core.Symbol, found map[core.Symbol]bool) {
+	switch ex := expr.(type) {
+	case core.Symbol:
+		for _, local := range locals {
+			if ex == local {
+				found[local] = true
+			}
+		}
+	case core.List:
+		for _, element := range ex.AsSlice() {
+			referencedLocals(element, locals, found)
+		}
+	case core.FunctionExpr:
+		for _, p := range ex.Parameters {
+			referencedLocals(p, locals, found)
+		}
+		referencedLocals(ex.Body, locals, found)
+	case core.Association:
+		for _, key := range ex.Keys() {
+			value, _ := ex.Get(key)
+			referencedLocals(key, locals, found)
+			referencedLocals(value, locals, found)
+		}
+	}
+}
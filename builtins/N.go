@@ -1,6 +1,7 @@
 package builtins
 
 import (
+	"fmt"
 	"math"
 
 	"github.com/client9/cardinal/core"
@@ -12,15 +13,22 @@ import (
 // @ExprSymbol N
 // @ExprAttributes Protected
 //
+// N(expr) walks expr converting exact numbers and numeric constants to
+// machine Reals.  N(expr, digits) asks for digits decimal digits of
+// precision, which is only honoured where an arbitrary precision
+// algorithm exists; everything else falls back to machine precision.
 
 // @ExprPattern (E)
 func N_E(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	return core.NewReal(math.E)
 }
 
-// @ExprPattern (E, _Number)
+// @ExprPattern (E, _Integer)
 func N_E_Prec(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	prec := args[1].(core.Integer).Int64()
+	prec, err := digitsToPrec(args[1].(core.Integer))
+	if err != nil {
+		return err
+	}
 	if prec <= 53 {
 		return core.NewReal(math.E)
 	}
@@ -50,10 +58,13 @@ func N_Pow_E(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr
 // generic N(E^2, 200) --> Power(N(E, 200), N(2,200)) -> mpfr.Pow(...)
 // special N(E^2, 200) --> mpfr.Exp(...)
 //
-// @ExprPattern (Power(E, _Number), _Number)
+// @ExprPattern (Power(E, _Number), _Integer)
 func N_Pow_E_Prec(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	powargs := args[0].(core.List).Tail()
-	prec := args[1].(core.Integer).Int64()
+	prec, err := digitsToPrec(args[1].(core.Integer))
+	if err != nil {
+		return err
+	}
 	exp := powargs[1].(core.Number)
 
 	// set exponent default precision
@@ -70,9 +81,12 @@ func N_Pi(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	return core.NewReal(math.Pi)
 }
 
-// @ExprPattern (Pi, _Number)
+// @ExprPattern (Pi, _Integer)
 func N_Pi_Prec(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	prec := args[1].(core.Integer).Int64()
+	prec, err := digitsToPrec(args[1].(core.Integer))
+	if err != nil {
+		return err
+	}
 	if prec <= 53 {
 		return core.NewReal(math.Pi)
 	}
@@ -88,7 +102,10 @@ func N_Rational(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.E
 // @ExprPattern (_Rational, _Integer)
 func N_RationalPrec(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	i := args[0].(core.Rational)
-	prec := args[1].(core.Integer).Int64()
+	prec, err := digitsToPrec(args[1].(core.Integer))
+	if err != nil {
+		return err
+	}
 	if prec <= 53 {
 		return core.NewReal(i.Float64())
 	}
@@ -104,7 +121,10 @@ func N_Integer(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Ex
 func N_IntegerPrec(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 
 	i := args[0].(core.Integer)
-	prec := args[1].(core.Integer).Int64()
+	prec, err := digitsToPrec(args[1].(core.Integer))
+	if err != nil {
+		return err
+	}
 	if prec <= 53 {
 		return core.NewReal(i.Float64())
 	}
@@ -122,6 +142,23 @@ func NPrec(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	return evalNumPrec(e, c, args[0], prec)
 }
 
+// maxPrecDigits is the most decimal digits of precision N accepts
+const maxPrecDigits = math.MaxInt32
+
+// digitsToPrec converts decimal digits to bits of precision.  Anything
+// at or below machine precision gives 53, and more than maxPrecDigits
+// is an ArgumentError.
+func digitsToPrec(digits core.Integer) (int64, core.Expr) {
+	if !digits.IsInt64() || digits.Int64() > maxPrecDigits {
+		return 0, core.NewError("ArgumentError", fmt.Sprintf("precision of %s digits is too large", digits))
+	}
+	prec := int64(math.Ceil(float64(digits.Int64()) * math.Log2(10)))
+	if prec < 53 {
+		return 53, nil
+	}
+	return prec, nil
+}
+
 func evalNumPrec(e *engine.Evaluator, c *engine.Context, arg core.Expr, prec core.Expr) core.Expr {
 	switch num := arg.(type) {
 	case core.List:
@@ -0,0 +1,35 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Nand
+// @ExprAttributes HoldAll Protected
+
+// Nand is Not(And(...)), stopping at the first False
+//
+// @ExprPattern (___)
+func Nand(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	var nonBooleanArgs []core.Expr
+	for _, arg := range args {
+		result := e.Evaluate(arg)
+		if val, ok := core.ExtractBool(result); ok {
+			if !val {
+				return core.NewBool(true)
+			}
+			continue
+		}
+		nonBooleanArgs = append(nonBooleanArgs, result)
+	}
+
+	switch len(nonBooleanArgs) {
+	case 0:
+		return core.NewBool(false)
+	case 1:
+		return core.ListFrom(symbol.Not, nonBooleanArgs[0])
+	}
+	return core.ListFrom(symbol.Nand, nonBooleanArgs...)
+}
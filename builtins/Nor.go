@@ -0,0 +1,35 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Nor
+// @ExprAttributes HoldAll Protected
+
+// Nor is Not(Or(...)), stopping at the first True
+//
+// @ExprPattern (___)
+func Nor(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	var nonBooleanArgs []core.Expr
+	for _, arg := range args {
+		result := e.Evaluate(arg)
+		if val, ok := core.ExtractBool(result); ok {
+			if val {
+				return core.NewBool(false)
+			}
+			continue
+		}
+		nonBooleanArgs = append(nonBooleanArgs, result)
+	}
+
+	switch len(nonBooleanArgs) {
+	case 0:
+		return core.NewBool(true)
+	case 1:
+		return core.ListFrom(symbol.Not, nonBooleanArgs[0])
+	}
+	return core.ListFrom(symbol.Nor, nonBooleanArgs...)
+}
@@ -7,9 +7,10 @@ import (
 
 // @ExprSymbol NumberQ
 
-// NumberQ checks if an expression is numeric (int or float)
+// NumberQ checks if an expression is numeric (integer, rational or float)
 //
 // @ExprPattern (_)
 func NumberQ(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	return core.NewBool(core.IsNumeric(args[0]))
+	_, isRational := args[0].(core.Rational)
+	return core.NewBool(isRational || core.IsNumeric(args[0]))
 }
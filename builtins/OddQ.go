@@ -0,0 +1,23 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol OddQ
+// @ExprAttributes Protected
+
+// OddQ checks if an integer is odd
+//
+// @ExprPattern (_Integer)
+func OddQ(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return core.NewBool(isOdd(args[0].(core.Integer)))
+}
+
+// OddQOther is False for anything that is not an integer
+//
+// @ExprPattern (_)
+func OddQOther(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return core.NewBool(false)
+}
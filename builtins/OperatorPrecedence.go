@@ -0,0 +1,20 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol OperatorPrecedence
+
+// OperatorPrecedence returns the precedence of an infix operator, builtin
+// or user-defined: OperatorPrecedence("+") returns 110.
+//
+// @ExprPattern (_String)
+func OperatorPrecedence(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if prec, ok := e.Operators().Precedence(string(args[0].(core.String))); ok {
+		return core.NewInteger(int64(prec))
+	}
+	return core.ListFrom(symbol.Missing, core.NewString("NotFound"))
+}
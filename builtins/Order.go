@@ -0,0 +1,18 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Order
+// @ExprAttributes Protected
+
+// Order gives 1 if a comes before b in canonical order, -1 if it comes
+// after and 0 if they are identical.  This is the ordering used by Sort
+// and the Orderless attribute.
+//
+// @ExprPattern (_, _)
+func Order(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return core.NewInteger(int64(-core.CompareExpr(args[0], args[1])))
+}
@@ -0,0 +1,23 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol OrderedQ
+// @ExprAttributes Protected
+
+// OrderedQ checks if the elements of an expression are in canonical
+// order, that is Sort would leave them unchanged.  The head can be
+// anything, not just List.
+//
+// @ExprPattern (_)
+func OrderedQ(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	list, ok := args[0].(core.List)
+	if !ok {
+		return core.ListFrom(symbol.OrderedQ, args[0])
+	}
+	return core.NewBool(core.InCanonicalOrder(list.Tail()))
+}
@@ -0,0 +1,34 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Out
+// @ExprAttributes Protected
+
+// Out is the last result recorded by the REPL, and is written %
+//
+// @ExprPattern ()
+func Out(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if result, ok := c.Output(-1); ok {
+		return result
+	}
+	return core.ListFrom(symbol.Out)
+}
+
+// OutN is result number n, written %n, or with a negative n the result
+// -n back, so %% is Out(-2)
+//
+// @ExprPattern (_Integer)
+func OutN(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	n, ok := core.ExtractInt64(args[0])
+	if ok && n != 0 {
+		if result, ok := c.Output(n); ok {
+			return result
+		}
+	}
+	return core.ListFrom(symbol.Out, args...)
+}
@@ -0,0 +1,35 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Outer
+// @ExprAttributes Protected
+
+// Outer applies f to every combination of elements, one from each
+// list.  The result is nested one level per list:
+// Outer(f, [a, b], [x, y]) is [[f(a, x), f(a, y)], [f(b, x), f(b, y)]]
+//
+// @ExprPattern (_, __List)
+func Outer(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return outer(e, args[0], args[1:], nil)
+}
+
+// outer fills in one argument per list, with the last list varying fastest
+func outer(e *engine.Evaluator, fn core.Expr, lists []core.Expr, chosen []core.Expr) core.Expr {
+	if len(lists) == 0 {
+		return e.Evaluate(core.ListFrom(fn, chosen...))
+	}
+	elements := lists[0].(core.List).Tail()
+	result := make([]core.Expr, len(elements))
+	for i, elem := range elements {
+		result[i] = outer(e, fn, lists[1:], append(chosen[:len(chosen):len(chosen)], elem))
+		if core.IsError(result[i]) {
+			return result[i]
+		}
+	}
+	return core.ListFrom(symbol.List, result...)
+}
@@ -1,8 +1,6 @@
 package builtins
 
 import (
-	"fmt"
-
 	"github.com/client9/cardinal/core"
 	"github.com/client9/cardinal/engine"
 )
@@ -13,19 +11,17 @@ import (
 // @ExprPattern (_, _Integer)
 func PartList(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	expr := args[0]
+	// integer keys of an association are keys, not positions
+	if keyed := core.AsKeyed(expr); keyed != nil {
+		return keyed.ElementByKey(args[1])
+	}
 	n, _ := core.ExtractInt64(args[1])
 	return core.Part(expr, n)
 }
 
-// PartAssociation extracts a value from an association by key
+// PartAssociation extracts a value from an association by key, or
+// Missing("KeyAbsent", key) if there is none
 // @ExprPattern (_Association, _)
 func PartAssociation(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	assoc := args[0].(core.Association)
-	key := args[1]
-	// For associations, use the key argument to lookup value
-	if value, exists := assoc.Get(key); exists {
-		return value
-	}
-	return core.NewError("PartError",
-		fmt.Sprintf("Key %s not found in association", key.String()))
+	return args[0].(core.Association).ElementByKey(args[1])
 }
@@ -0,0 +1,7 @@
+package builtins
+
+// @ExprSymbol PatternTest
+// @ExprAttributes HoldRest
+//
+// PatternTest(pattern, test) or pattern?test matches only when the pattern
+// matches and test(expr) evaluates to True, e.g. f(x_?EvenQ) := ...
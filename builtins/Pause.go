@@ -0,0 +1,39 @@
+package builtins
+
+import (
+	"math"
+	"time"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Pause
+// @ExprAttributes Protected
+
+// Pause sleeps for the given number of seconds and returns Null:
+// Pause(0.5)
+// Within TimeConstrained it stops at the time limit.
+// It is disabled in safe mode, since it blocks.
+//
+// @ExprPattern (_Number)
+func Pause(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if e.SafeMode() {
+		return core.NewError("SecurityError", "Pause is disabled in safe mode")
+	}
+	seconds, _ := core.GetNumericValue(args[0])
+	if seconds < 0 {
+		return core.NewError("ArgumentError", "Pause requires a non-negative duration")
+	}
+	d := secondsDuration(seconds)
+	if d == math.MaxInt64 {
+		return core.NewError("ArgumentError", "Pause duration is too long")
+	}
+	// within TimeConstrained, sleep no longer than the time that is left
+	if deadline, ok := c.Deadline(); ok {
+		d = min(d, time.Until(deadline))
+	}
+	time.Sleep(d)
+	return symbol.Null
+}
@@ -0,0 +1,33 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Position
+
+// Position returns the parts of expr that match pattern, at any level:
+// Position([1, [2, 3]], _Integer) returns [[1], [2, 1], [2, 2]]
+// Inner parts are listed before the parts that contain them.  Heads are
+// not searched.
+//
+// @ExprPattern (_, _)
+func Position(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	var result []core.Expr
+	findPositions(e, args[0], args[1], nil, &result)
+	return core.ListFrom(symbol.List, result...)
+}
+
+// findPositions appends the position of each match in expr to result
+func findPositions(e *engine.Evaluator, expr, pattern core.Expr, path []core.Expr, result *[]core.Expr) {
+	if list, ok := expr.(core.List); ok {
+		for i, element := range list.Tail() {
+			findPositions(e, element, pattern, append(path[:len(path):len(path)], core.NewInteger(int64(i+1))), result)
+		}
+	}
+	if matches, _ := e.Match(expr, pattern); matches {
+		*result = append(*result, core.ListFrom(symbol.List, path...))
+	}
+}
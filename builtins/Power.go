@@ -1,8 +1,6 @@
 package builtins
 
 import (
-	"fmt"
-
 	"github.com/client9/cardinal/core"
 	"github.com/client9/cardinal/core/big"
 	"github.com/client9/cardinal/core/symbol"
@@ -43,15 +41,10 @@ func PowerNumberInvReal(e *engine.Evaluator, c *engine.Context, args []core.Expr
 
 // @ExprPattern (_Number, -1)
 func PowerNumberInv(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-	fmt.Println("IN POWER -1")
 	arg := args[0].(core.Number)
-	fmt.Println("In power number inv: ", arg)
 	if arg.Sign() == 0 {
-
 		return core.NewError("DivisionByZero", "Division by zero")
 	}
-	result := arg.AsInv()
-	fmt.Println(result)
 	return arg.AsInv()
 }
 
@@ -69,9 +62,17 @@ func PowerInteger(e *engine.Evaluator, c *engine.Context, args []core.Expr) core
 		return core.NewInteger(1)
 	case -1:
 		// x ^ -y == 1/ (x^y)
-		return core.PowerInteger(x, y.AsNeg().(core.Integer)).AsInv()
+		r, err := core.PowerInteger(x, y.AsNeg().(core.Integer))
+		if err != nil {
+			return core.NewError("Overflow", err.Error())
+		}
+		return r.AsInv()
 	default:
-		return core.PowerInteger(x, y)
+		r, err := core.PowerInteger(x, y)
+		if err != nil {
+			return core.NewError("Overflow", err.Error())
+		}
+		return r
 	}
 }
 
@@ -140,6 +141,29 @@ func PowerRealToInt(e *engine.Evaluator, c *engine.Context, args []core.Expr) co
 
 }
 
+// PowerRealToRat raises a Real to a Rational power in floating point:
+// 2.0^(1/2) is 1.4142135623730951
+//
+// @ExprPattern (_Real, _Rational)
+func PowerRealToRat(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return powerFloat64(args[0].(core.Number), args[1].(core.Number))
+}
+
+// PowerRatToReal raises a Rational to a Real power in floating point
+//
+// @ExprPattern (_Rational, _Real)
+func PowerRatToReal(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return powerFloat64(args[0].(core.Number), args[1].(core.Number))
+}
+
+func powerFloat64(base, exp core.Number) core.Expr {
+	result, err := core.PowerFloat64(base.Float64(), exp.Float64())
+	if err != nil {
+		return core.NewError("DivisionByZero", "Division by zero")
+	}
+	return core.NewReal(result)
+}
+
 // PowerNumbers performs power operation on numeric arguments
 // Returns (float64, error) for clear type safety
 // TODO: Error handling
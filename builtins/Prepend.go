@@ -0,0 +1,22 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Prepend
+
+// Prepend adds an expression to the start of a List
+//
+// @ExprPattern (_List, _)
+func Prepend(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return args[0].(core.List).Prepend(args[1])
+}
+
+// @ExprPattern (_String, _String)
+func PrependString(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	a := args[0].(core.String)
+	b := args[1].(core.String)
+	return core.NewString(string(b) + string(a))
+}
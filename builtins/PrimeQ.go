@@ -0,0 +1,97 @@
+package builtins
+
+import (
+	"math/bits"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/big"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol PrimeQ
+// @ExprAttributes Protected
+
+// PrimeQ checks if an integer is prime.  As in Mathematica a negative
+// number is prime when its absolute value is: PrimeQ(-7) is True.
+//
+// Machine integers use a deterministic Miller-Rabin test, big
+// integers a probabilistic one with enough rounds to be exact in practice.
+//
+// @ExprPattern (_Integer)
+func PrimeQ(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	n := args[0].(core.Integer)
+	if n.IsInt64() {
+		v := n.Int64()
+		u := uint64(v)
+		if v < 0 {
+			u = uint64(-v)
+		}
+		return core.NewBool(isPrime64(u))
+	}
+	return core.NewBool(new(big.Int).Abs(n.AsBigInt()).ProbablyPrime(20))
+}
+
+// PrimeQOther is False for anything that is not an integer
+//
+// @ExprPattern (_)
+func PrimeQOther(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return core.NewBool(false)
+}
+
+// millerRabinBases are enough witnesses to make Miller-Rabin
+// deterministic for every 64-bit integer
+var millerRabinBases = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+func isPrime64(n uint64) bool {
+	if n < 2 {
+		return false
+	}
+	for _, p := range millerRabinBases {
+		if n%p == 0 {
+			return n == p
+		}
+	}
+
+	// n-1 = d * 2^s with d odd
+	d := n - 1
+	s := bits.TrailingZeros64(d)
+	d >>= s
+
+	for _, a := range millerRabinBases {
+		x := powMod64(a, d, n)
+		if x == 1 || x == n-1 {
+			continue
+		}
+		composite := true
+		for i := 1; i < s; i++ {
+			x = mulMod64(x, x, n)
+			if x == n-1 {
+				composite = false
+				break
+			}
+		}
+		if composite {
+			return false
+		}
+	}
+	return true
+}
+
+func mulMod64(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	_, rem := bits.Div64(hi%m, lo, m)
+	return rem
+}
+
+func powMod64(base, exp, m uint64) uint64 {
+	result := uint64(1)
+	base %= m
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = mulMod64(result, base, m)
+		}
+		base = mulMod64(base, base, m)
+		exp >>= 1
+	}
+	return result
+}
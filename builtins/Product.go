@@ -0,0 +1,25 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Product
+// @ExprAttributes HoldAll Protected
+
+// Product multiplies expr over an iterator, like Sum:
+// Product(i, [i, 1, 5]) is 120.
+//
+// @ExprPattern (_,_)
+func Product(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	terms, err := iteratorTerms(e, c, "Product", args[0], args[1])
+	if err != nil {
+		return err
+	}
+	if len(terms) == 0 {
+		return core.NewInteger(1)
+	}
+	return e.Evaluate(core.ListFrom(symbol.Times, terms...))
+}
@@ -0,0 +1,21 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Quiet
+// @ExprAttributes HoldAll Protected
+
+// Quiet evaluates expr, discarding any diagnostics from Message.
+// Errors are still returned: Quiet(Message(f, 1); 2) is 2 with no
+// message.
+//
+// @ExprPattern (_)
+func Quiet(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	messages := len(c.Messages())
+	result := e.Evaluate(args[0])
+	c.TruncateMessages(messages)
+	return result
+}
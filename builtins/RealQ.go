@@ -0,0 +1,16 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol RealQ
+// @ExprAttributes Protected
+
+// RealQ checks if an expression is a real number
+//
+// @ExprPattern (_)
+func RealQ(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return core.NewBool(core.MatchesType(args[0], "Real"))
+}
@@ -0,0 +1,40 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol ReleaseHold
+// @ExprAttributes Protected
+
+// ReleaseHold removes the outermost Hold and HoldForm wrappers in
+// expr, so their contents are evaluated: ReleaseHold(Hold(1 + 1)) is 2.
+// Wrappers inside held contents are kept, as is a Hold of several
+// expressions.
+//
+// @ExprPattern (_)
+func ReleaseHold(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return e.Evaluate(releaseHold(args[0]))
+}
+
+func releaseHold(expr core.Expr) core.Expr {
+	list, ok := expr.(core.List)
+	if !ok {
+		return expr
+	}
+	switch list.Head() {
+	case symbol.Hold, symbol.HoldForm:
+		if list.Length() == 1 {
+			return list.Tail()[0]
+		}
+		return expr
+	}
+	elements := list.Tail()
+	result := make([]core.Expr, len(elements))
+	for i, elem := range elements {
+		result[i] = releaseHold(elem)
+	}
+	return core.ListFrom(list.Head(), result...)
+}
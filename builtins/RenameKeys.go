@@ -0,0 +1,50 @@
+package builtins
+
+import (
+	"fmt"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol RenameKeys
+
+// RenameKeys renames selected keys of an association:
+// RenameKeys(assoc, old:new) or RenameKeys(assoc, [old1:new1, old2:new2])
+//
+// Keys not mentioned are kept, and renames for missing keys are ignored.
+// Collisions follow KeyMap: the value seen last wins.
+//
+// @ExprPattern (_Association, _Rule)
+func RenameKeys(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return renameKeys(args[0].(core.Association), []core.Expr{args[1]})
+}
+
+// @ExprPattern (_Association, _List)
+func RenameKeysList(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return renameKeys(args[0].(core.Association), args[1].(core.List).Tail())
+}
+
+func renameKeys(assoc core.Association, rules []core.Expr) core.Expr {
+	renames := core.NewAssociation()
+	for _, rule := range rules {
+		ruleList, ok := rule.(core.List)
+		if !ok || ruleList.Head() != symbol.Rule || ruleList.Length() != 2 {
+			return core.NewError("ArgumentError",
+				fmt.Sprintf("RenameKeys expects Rule expressions, got %s", rule.String()))
+		}
+		ruleArgs := ruleList.Tail()
+		renames = renames.Set(ruleArgs[0], ruleArgs[1])
+	}
+
+	result := core.NewAssociation()
+	for _, key := range assoc.Keys() {
+		value, _ := assoc.Get(key)
+		if newKey, ok := renames.Get(key); ok {
+			key = newKey
+		}
+		result = result.Set(key, value)
+	}
+	return result
+}
@@ -0,0 +1,8 @@
+package builtins
+
+// @ExprSymbol Repeated
+// @ExprAttributes Protected
+//
+// Repeated(p) or p.. matches one or more elements that each match p.
+// Repeated(p, n) matches at most n, Repeated(p, [n]) exactly n, and
+// Repeated(p, [min, max]) between min and max elements.
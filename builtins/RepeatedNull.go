@@ -0,0 +1,6 @@
+package builtins
+
+// @ExprSymbol RepeatedNull
+// @ExprAttributes Protected
+//
+// RepeatedNull(p) or p... is like Repeated, but also matches no elements.
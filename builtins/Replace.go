@@ -44,12 +44,12 @@ func isRuleList(expr core.Expr) bool {
 }
 
 // applyRuleDelayedAware applies a rule (Rule or RuleDelayed) with proper handling for both types
-func applyRuleDelayedAware(expr core.Expr, rule core.Expr) core.Expr {
+func applyRuleDelayedAware(e *engine.Evaluator, expr core.Expr, rule core.Expr) core.Expr {
 	// Handle both Rule and RuleDelayed
 
 	if pattern, replacement, ok := asRule(rule); ok {
 		// Use pattern matching with variable binding
-		if matches, bindings := core.MatchWithBindings(expr, pattern); matches {
+		if matches, bindings := e.Match(expr, pattern); matches {
 			return core.SubstituteBindings(replacement, bindings)
 		}
 	}
@@ -70,7 +70,7 @@ func Replace(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr
 	rule := args[1]
 	// Handle single rule
 	if isRuleOrRuleDelayed(rule) {
-		return applyRuleDelayedAware(expr, rule)
+		return applyRuleDelayedAware(e, expr, rule)
 	}
 
 	if !isRuleList(rule) {
@@ -84,7 +84,7 @@ func Replace(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr
 	// Only process as rule list if ALL elements are rules
 	// Try each rule in order
 	for _, ruleItem := range ruleSlice {
-		result := applyRuleDelayedAware(expr, ruleItem)
+		result := applyRuleDelayedAware(e, expr, ruleItem)
 		if !result.Equal(expr) {
 			return result
 		}
@@ -27,7 +27,7 @@ func ReplaceAll(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.E
 		return rule
 	}
 
-	result := replaceAllRecursive(expr, rule)
+	result := replaceAllRecursive(e, expr, rule)
 	if !result.Equal(expr) {
 		return result
 	}
@@ -46,13 +46,13 @@ func ReplaceAll(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.E
 }
 
 // replaceAllRecursive recursively applies rules to all subexpressions
-func replaceAllRecursive(expr core.Expr, rule core.Expr) core.Expr {
+func replaceAllRecursive(e *engine.Evaluator, expr core.Expr, rule core.Expr) core.Expr {
 	// First try to apply the rule at this level
 	var result core.Expr
 
 	// Handle single rule
 	if isRuleOrRuleDelayed(rule) {
-		result = applyRuleDelayedAware(expr, rule)
+		result = applyRuleDelayedAware(e, expr, rule)
 		if !result.Equal(expr) {
 			// Rule matched at this level, return the result (don't recurse into replacement)
 			return result
@@ -73,7 +73,7 @@ func replaceAllRecursive(expr core.Expr, rule core.Expr) core.Expr {
 		if allAreRules {
 			// Try each rule in order
 			for _, ruleItem := range rulesSlice {
-				result = applyRuleDelayedAware(expr, ruleItem)
+				result = applyRuleDelayedAware(e, expr, ruleItem)
 				if !result.Equal(expr) {
 					// Rule matched at this level
 					return result
@@ -89,7 +89,7 @@ func replaceAllRecursive(expr core.Expr, rule core.Expr) core.Expr {
 		changed := false
 
 		for i, element := range list.AsSlice() {
-			newElement := replaceAllRecursive(element, rule)
+			newElement := replaceAllRecursive(e, element, rule)
 			newElements[i] = newElement
 			if !newElement.Equal(element) {
 				changed = true
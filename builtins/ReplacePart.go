@@ -0,0 +1,49 @@
+package builtins
+
+import (
+	"slices"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol ReplacePart
+// @ExprAttributes Protected
+
+// ReplacePart replaces the part at a position with a new value:
+// ReplacePart([a, [b, c]], [2, 1] : x) is [a, [x, c]]
+// The position is as for Delete, so index 0 is the head and a list of
+// paths replaces several parts.  A list of rules applies each in turn.
+//
+// @ExprPattern (_, _)
+func ReplacePart(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	expr := args[0]
+	rules := []core.Expr{args[1]}
+	if list, ok := args[1].(core.List); ok && list.Head() == symbol.List {
+		rules = list.Tail()
+	}
+	for _, rule := range rules {
+		r, ok := rule.(core.List)
+		if !ok || r.Length() != 2 || (r.Head() != symbol.Rule && r.Head() != symbol.RuleDelayed) {
+			return core.NewError("ArgumentError", "ReplacePart expects a rule position : value")
+		}
+		paths, err := partPaths(expr, r.Tail()[0])
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			expr = replaceAt(expr, path, r.Tail()[1])
+		}
+	}
+	return expr
+}
+
+func replaceAt(expr core.Expr, path []int64, value core.Expr) core.Expr {
+	if len(path) == 0 {
+		return value
+	}
+	elements := slices.Clone(expr.(core.List).AsSlice())
+	elements[path[0]] = replaceAt(elements[path[0]], path[1:], value)
+	return core.NewListFromExprs(elements...)
+}
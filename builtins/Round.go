@@ -0,0 +1,136 @@
+package builtins
+
+import (
+	"math"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/big"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Round
+// @ExprAttributes Protected
+
+// @ExprPattern (_Integer)
+func RoundInteger(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return args[0]
+}
+
+// @ExprPattern (_Rational)
+func RoundRational(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return roundRat(args[0].(core.Rational).AsBigRat())
+}
+
+// RoundReal rounds to the nearest integer.  Halfway cases round to the
+// even neighbor, so Round(2.5) is 2 and Round(3.5) is 4.
+//
+// @ExprPattern (_Real)
+func RoundReal(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	r := args[0].(core.Real)
+	if r.IsFloat64() {
+		return integerFromFloat64(math.RoundToEven(r.Float64()))
+	}
+
+	// Big Real
+	x := r.AsBigFloat()
+	f := new(big.Float).Floor(x)
+	q := normalizeBigInt(f.Int())
+	frac := new(big.Float).Sub(x, f)
+	switch frac.Cmp(big.NewFloat(0.5)) {
+	case -1:
+		return q
+	case 0:
+		if isOdd(q) {
+			return incInteger(q)
+		}
+		return q
+	}
+	return incInteger(q)
+}
+
+// RoundMultiple rounds x to the nearest multiple of a: Round(7.3, 2) is 8
+// The result is an exact multiple when a is exact, and Real otherwise.
+//
+// @ExprPattern (_, _)
+func RoundMultiple(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	x, xok := args[0].(core.Number)
+	a, aok := args[1].(core.Number)
+	if !xok || !aok {
+		return core.NewList(symbol.Round, args...)
+	}
+	if a.Sign() == 0 {
+		return core.NewError("DivisionByZero", "Round to a multiple of zero")
+	}
+
+	ar, aexact := core.ExactRat(a)
+	if !aexact {
+		k := math.RoundToEven(x.Float64() / a.Float64())
+		return core.NewReal(k * a.Float64())
+	}
+
+	var k core.Integer
+	if xr, xexact := core.ExactRat(x); xexact {
+		k = roundRat(new(big.Rat).Quo(xr, ar))
+	} else {
+		k = integerFromFloat64(math.RoundToEven(x.Float64() / a.Float64()))
+	}
+	return normalizeBigRat(new(big.Rat).Mul(new(big.Rat).SetInt(k.AsBigInt()), ar))
+}
+
+// floorRat returns the largest integer not greater than r
+func floorRat(r *big.Rat) core.Integer {
+	q, _ := new(big.Int).DivMod(r.Num(), r.Denom(), new(big.Int))
+	return normalizeBigInt(q)
+}
+
+// roundRat rounds r to the nearest integer, with halfway cases going
+// to the even neighbor
+func roundRat(r *big.Rat) core.Integer {
+	q, m := new(big.Int).DivMod(r.Num(), r.Denom(), new(big.Int))
+	// compare the remainder m/d against 1/2
+	twice := new(big.Int).Add(m, m)
+	switch twice.Cmp(r.Denom()) {
+	case -1:
+		return normalizeBigInt(q)
+	case 0:
+		if q.Bit(0) == 0 {
+			return normalizeBigInt(q)
+		}
+	}
+	return normalizeBigInt(q.Add(q, big.NewInt(1)))
+}
+
+// integerFromFloat64 converts an integral float64 to an Integer,
+// using a big integer if it does not fit in an int64
+func integerFromFloat64(f float64) core.Integer {
+	if f >= -(1<<63) && f < (1<<63) {
+		return core.NewInteger(int64(f))
+	}
+	r := new(big.Rat)
+	r.SetFloat64(f)
+	return r.Num()
+}
+
+// normalizeBigRat returns an Integer when r has denominator 1
+func normalizeBigRat(r *big.Rat) core.Expr {
+	if r.IsInt() {
+		return normalizeBigInt(r.Num())
+	}
+	return r
+}
+
+// normalizeBigInt returns a machine integer when z fits in an int64
+func normalizeBigInt(z *big.Int) core.Integer {
+	if z.IsInt64() {
+		return core.NewInteger(z.Int64())
+	}
+	return z
+}
+
+func incInteger(n core.Integer) core.Integer {
+	if n.IsInt64() && n.Int64() < math.MaxInt64 {
+		return core.NewInteger(n.Int64() + 1)
+	}
+	return new(big.Int).Add(n.AsBigInt(), big.NewInt(1))
+}
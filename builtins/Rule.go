@@ -7,7 +7,7 @@ import (
 )
 
 // @ExprSymbol Rule
-// @ExprAttributes HoldRest Protected
+// @ExprAttributes HoldRest Protected SequenceHold
 //
 // Rule
 // TODO: this seems wrong
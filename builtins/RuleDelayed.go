@@ -7,7 +7,7 @@ import (
 )
 
 // @ExprSymbol RuleDelayed
-// @ExprAttributes HoldRest
+// @ExprAttributes HoldRest SequenceHold
 
 // RuleDelayed creates delayed rules: RuleDelayed(lhs, rhs)
 // TODO: this seems wrong
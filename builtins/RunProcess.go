@@ -0,0 +1,63 @@
+package builtins
+
+import (
+	"bytes"
+	"errors"
+	"os/exec"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol RunProcess
+// @ExprAttributes Protected
+
+// RunProcess runs an external command and waits for it to finish:
+// RunProcess(["echo", "hello"]) returns
+// {"ExitCode": 0, "StandardOutput": "hello\n", "StandardError": ""}
+//
+// The command is run directly, not through a shell, with the full
+// privileges of the current process.  Since this allows a script to do
+// anything the user can, it is disabled unless explicitly allowed with
+// Evaluator.SetAllowExec (the REPL's -allow-exec flag), and is always
+// disabled in safe mode.
+//
+// @ExprPattern (_List)
+func RunProcess(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if !e.AllowExec() {
+		return core.NewError("SecurityError", "RunProcess is disabled")
+	}
+
+	list := args[0].(core.List)
+	if list.Length() == 0 {
+		return core.NewError("ArgumentError", "RunProcess requires a command")
+	}
+	argv := make([]string, 0, list.Length())
+	for _, arg := range list.Tail() {
+		s, ok := core.ExtractString(arg)
+		if !ok {
+			return core.NewError("TypeError", "RunProcess expects a list of strings")
+		}
+		argv = append(argv, s)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(argv[0], argv[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	exitCode := 0
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			// the command could not be started
+			return core.NewError("ProcessError", err.Error())
+		}
+		exitCode = exitErr.ExitCode()
+	}
+
+	return core.NewAssociation().
+		Set(core.NewString("ExitCode"), core.NewInteger(int64(exitCode))).
+		Set(core.NewString("StandardOutput"), core.NewString(stdout.String())).
+		Set(core.NewString("StandardError"), core.NewString(stderr.String()))
+}
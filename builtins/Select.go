@@ -0,0 +1,48 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Select
+
+// Select returns the elements for which pred returns True:
+// Select([1, a, 2], MatchQ(_Integer)) returns [1, 2]
+//
+// @ExprPattern (_(___), _)
+func Select(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return selectN(e, args[0].(core.List), args[1], -1)
+}
+
+// SelectN returns at most n elements for which pred returns True:
+// Select(list, pred, n)
+//
+// @ExprPattern (_(___), _, _Integer)
+func SelectN(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	n, _ := core.ExtractInt64(args[2])
+	if n < 0 {
+		return core.NewError("ArgumentError", "Select requires a non-negative count")
+	}
+	return selectN(e, args[0].(core.List), args[1], n)
+}
+
+// selectN keeps the head of list, and stops once n elements are found
+// unless n is negative
+func selectN(e *engine.Evaluator, list core.List, pred core.Expr, n int64) core.Expr {
+	var result []core.Expr
+	for _, element := range list.Tail() {
+		if n >= 0 && int64(len(result)) >= n {
+			break
+		}
+		ok := e.Evaluate(core.ListFrom(pred, element))
+		if core.IsError(ok) {
+			return ok
+		}
+		if ok == symbol.True {
+			result = append(result, element)
+		}
+	}
+	return core.ListFrom(list.Head(), result...)
+}
@@ -0,0 +1,39 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol SelectFirst
+
+// SelectFirst returns the first element for which pred returns True:
+// SelectFirst(list, pred) returns Missing("NotFound") if there is none.
+//
+// @ExprPattern (_(___), _)
+func SelectFirst(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return selectFirst(e, args[0].(core.List), args[1], core.ListFrom(symbol.Missing, core.NewString("NotFound")))
+}
+
+// SelectFirstDefault returns the first element for which pred returns True,
+// or default if there is none: SelectFirst(list, pred, default)
+//
+// @ExprPattern (_(___), _, _)
+func SelectFirstDefault(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return selectFirst(e, args[0].(core.List), args[1], args[2])
+}
+
+// selectFirst stops evaluating pred as soon as an element is accepted
+func selectFirst(e *engine.Evaluator, list core.List, pred core.Expr, def core.Expr) core.Expr {
+	for _, element := range list.Tail() {
+		result := e.Evaluate(core.ListFrom(pred, element))
+		if core.IsError(result) {
+			return result
+		}
+		if result == symbol.True {
+			return element
+		}
+	}
+	return def
+}
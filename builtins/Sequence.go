@@ -0,0 +1,8 @@
+package builtins
+
+// @ExprSymbol Sequence
+// @ExprAttributes Protected
+//
+// Sequence(a, b, ...) is spliced into the arguments of the function it
+// is passed to: f(1, Sequence(2, 3)) is f(1, 2, 3). Held arguments are
+// not spliced.
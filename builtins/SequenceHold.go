@@ -0,0 +1,7 @@
+package builtins
+
+// @ExprSymbol SequenceHold
+// @ExprAttributes Protected
+//
+// SequenceHold keeps Sequence arguments of a function from being spliced,
+// so s = Sequence(1, 2) assigns the whole Sequence.
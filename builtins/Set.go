@@ -1,18 +1,25 @@
 package builtins
 
 import (
+	"errors"
+
 	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
 	"github.com/client9/cardinal/engine"
 )
 
 // @ExprSymbol Set
-// @ExprAttributes HoldFirst
+// @ExprAttributes HoldFirst SequenceHold
 
 // SetExpr evaluates immediate assignment: Set(lhs, rhs)
 // @ExprPattern (_,_)
 func SetExpr(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	lhs := args[0]
 	rhs := args[1]
+	// HoldPattern(f(1)) = value is the same as f(1) = value
+	if lhs.Head() == symbol.HoldPattern && lhs.Length() == 1 {
+		lhs = lhs.(core.List).Tail()[0]
+	}
 	// Evaluate the right-hand side immediately
 	evalRhs := e.Evaluate(rhs)
 
@@ -20,10 +27,52 @@ func SetExpr(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr
 
 	if symbolName, ok := lhs.(core.Symbol); ok {
 		if err := c.Set(symbolName, evalRhs); err != nil {
+			if errors.Is(err, engine.ErrLimitValue) {
+				return core.NewError("ArgumentError", err.Error())
+			}
 			return core.NewError("Protected", err.Error())
 		}
 		return evalRhs
 	}
 
+	// Handle definitions for specific arguments: f(5) = value
+	// The arguments are evaluated, and the definition is a literal
+	// pattern which is tried before more general ones.  This is what
+	// makes memoization work: f(n_) := f(n) = ...
+	if list, ok := lhs.(core.List); ok && list.Length() > 0 {
+		// DownValues(f) = rules replaces the definitions of f
+		if list.Head() == symbol.DownValues && list.Length() == 1 {
+			return setDownValues(c, list, evalRhs)
+		}
+		// Default(f) = value sets the value of omitted x_. arguments of f
+		if list.Head() == symbol.Default {
+			if list.Length() != 1 {
+				return core.NewError("ArgumentError", "only Default(f) = value is supported, not "+list.String()+" = value")
+			}
+			return setDefault(c, list, evalRhs)
+		}
+		if head, ok := list.Head().(core.Symbol); ok {
+			if c.GetSymbolTable().HasAttribute(head, engine.Protected) {
+				return core.NewError("Protected", "symbol "+head.String()+" is Protected")
+			}
+			if core.IsError(evalRhs) {
+				return evalRhs
+			}
+			args := list.Tail()
+			evalArgs := make([]core.Expr, len(args))
+			for i, arg := range args {
+				evalArgs[i] = e.Evaluate(arg)
+				if core.IsError(evalArgs[i]) {
+					return evalArgs[i]
+				}
+			}
+			pattern := core.ListFrom(list.Head(), evalArgs...)
+			if err := c.GetFunctionRegistry().RegisterUserFunction(pattern, evalRhs); err != nil {
+				return core.NewError("DefinitionError", err.Error())
+			}
+			return evalRhs
+		}
+	}
+
 	return core.NewError("SetError", "Invalid assignment target")
 }
@@ -18,6 +18,9 @@ func SetAttributesSingle(e *engine.Evaluator, c *engine.Context, args []core.Exp
 	if attr == 0 {
 		return core.NewError("UnknownAttribute", "unknown attribute")
 	}
+	if err := checkAttributeChange(c, sym, attr); err != nil {
+		return err
+	}
 	symbolTable := c.GetSymbolTable()
 	symbolTable.SetAttributes(sym, attr)
 	return symbol.Null
@@ -37,7 +40,25 @@ func SetAttributesList(e *engine.Evaluator, c *engine.Context, args []core.Expr)
 		attribute |= attr
 	}
 
+	if err := checkAttributeChange(c, sym, attribute); err != nil {
+		return err
+	}
 	symbolTable := c.GetSymbolTable()
 	symbolTable.SetAttributes(sym, attribute)
 	return symbol.Null
 }
+
+// checkAttributeChange returns an error if the attributes of sym may not
+// be changed.  A Protected symbol only allows Protected itself to be set
+// or cleared, so it can be unprotected first.  A Locked symbol allows no
+// changes at all.
+func checkAttributeChange(c *engine.Context, sym core.Symbol, attrs engine.Attribute) core.Expr {
+	symbolTable := c.GetSymbolTable()
+	if symbolTable.HasAttribute(sym, engine.Locked) {
+		return core.NewError("Protected", "symbol "+sym.String()+" is Locked")
+	}
+	if symbolTable.HasAttribute(sym, engine.Protected) && attrs&^engine.Protected != 0 {
+		return core.NewError("Protected", "symbol "+sym.String()+" is Protected")
+	}
+	return nil
+}
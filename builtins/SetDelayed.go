@@ -7,7 +7,7 @@ import (
 )
 
 // @ExprSymbol SetDelayed
-// @ExprAttributes HoldAll
+// @ExprAttributes HoldAll SequenceHold
 //
 
 // SetDelayedExpr evaluates delayed assignment: SetDelayed(lhs, rhs)
@@ -15,6 +15,17 @@ import (
 func SetDelayedExpr(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	lhs := args[0]
 	rhs := args[1]
+	// f(x_) /; test := body is the same as f(x_) := body /; test
+	if p, cond := core.IsCondition(lhs); p != nil {
+		if body, inner := core.IsCondition(rhs); body != nil {
+			rhs, cond = body, core.ListFrom(symbol.And, cond, inner)
+		}
+		lhs, rhs = p, core.ListFrom(symbol.Condition, rhs, cond)
+	}
+	// HoldPattern(f(x_)) := body is the same as f(x_) := body
+	if lhs.Head() == symbol.HoldPattern && lhs.Length() == 1 {
+		lhs = lhs.(core.List).Tail()[0]
+	}
 	// Handle function definitions: f(x_) := body
 	if list, ok := lhs.(core.List); ok && list.Length() > 0 {
 		// This is a function definition
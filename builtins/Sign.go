@@ -0,0 +1,24 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Sign
+// @ExprAttributes NumericFunction Protected
+
+// @ExprPattern (_Integer)
+func SignInteger(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return core.NewInteger(int64(args[0].(core.Integer).Sign()))
+}
+
+// @ExprPattern (_Rational)
+func SignRational(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return core.NewInteger(int64(args[0].(core.Rational).Sign()))
+}
+
+// @ExprPattern (_Real)
+func SignReal(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return core.NewInteger(int64(args[0].(core.Real).Sign()))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/client9/cardinal/core"
 	"github.com/client9/cardinal/core/big"
+	"github.com/client9/cardinal/core/symbol"
 	"github.com/client9/cardinal/engine"
 )
 
@@ -19,3 +20,56 @@ func Sin(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	}
 	return new(big.Float).SetPrec(r.Prec()).Sin(r.AsBigFloat())
 }
+
+// SinExact gives exact values at multiples of Pi/2, otherwise Sin
+// stays unevaluated.  Use N for a numeric value.
+//
+// @ExprPattern (_)
+func SinExact(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	k, ok := halfPiMultiple(args[0])
+	if !ok {
+		return core.ListFrom(symbol.Sin, args[0])
+	}
+	return core.NewInteger([]int64{0, 1, 0, -1}[k])
+}
+
+// halfPiMultiple returns k in 0..3 if x is an exact multiple of Pi/2,
+// that is x == (4n + k) * Pi/2 for some integer n
+func halfPiMultiple(x core.Expr) (int64, bool) {
+	if x == symbol.Pi {
+		return 2, true
+	}
+	var q core.Number
+	switch v := x.(type) {
+	case core.Integer:
+		if v.Sign() != 0 {
+			return 0, false
+		}
+		return 0, true
+	case core.List:
+		// Times is Orderless so the number comes first
+		if v.Head() != symbol.Times || v.Length() != 2 || v.Tail()[1] != symbol.Pi {
+			return 0, false
+		}
+		n, ok := v.Tail()[0].(core.Number)
+		if !ok {
+			return 0, false
+		}
+		q = n
+	default:
+		return 0, false
+	}
+	r, ok := core.ExactRat(q)
+	if !ok {
+		return 0, false
+	}
+	twice := new(big.Rat).Add(r, r)
+	if !twice.IsInt() {
+		return 0, false
+	}
+	k := new(big.Int).Rem(twice.Num(), big.NewInt(4)).Int64()
+	if k < 0 {
+		k += 4
+	}
+	return k, true
+}
@@ -0,0 +1,70 @@
+package builtins
+
+import (
+	"sort"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol SortBy
+// @ExprAttributes Protected
+
+// SortBy sorts the elements of a list by the canonical order of f(element):
+// SortBy([-3, 1, -2], Abs)
+// Elements with equal f values keep their original order.
+//
+// @ExprPattern (_List, _)
+func SortBy(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	list := args[0].(core.List)
+	elements := list.Tail()
+	order, err := sortByKeys(e, elements, args[1])
+	if err != nil {
+		return err
+	}
+
+	sorted := make([]core.Expr, len(order))
+	for i, idx := range order {
+		sorted[i] = elements[idx]
+	}
+	return core.NewList(list.Head(), sorted...)
+}
+
+// SortByAssociation reorders the entries of an association by the
+// canonical order of f(value): SortBy({a: 3, b: 1}, Abs)
+//
+// @ExprPattern (_Association, _)
+func SortByAssociation(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	assoc := args[0].(core.Association)
+	keys := assoc.Keys()
+	values := assoc.Values()
+	order, err := sortByKeys(e, values, args[1])
+	if err != nil {
+		return err
+	}
+
+	result := core.NewAssociation()
+	for _, idx := range order {
+		result = result.Set(keys[idx], values[idx])
+	}
+	return result
+}
+
+// sortByKeys evaluates f on each element and returns the element indices
+// in stable sorted order of the results
+func sortByKeys(e *engine.Evaluator, elements []core.Expr, fn core.Expr) ([]int, core.Expr) {
+	keys := make([]core.Expr, len(elements))
+	order := make([]int, len(elements))
+	for i, element := range elements {
+		key := e.Evaluate(core.ListFrom(fn, element))
+		if core.IsError(key) {
+			return nil, key
+		}
+		keys[i] = key
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return core.CanonicalCompare(keys[order[i]], keys[order[j]])
+	})
+	return order, nil
+}
@@ -1,7 +1,6 @@
 package builtins
 
 import (
-	"fmt"
 	"math"
 
 	"github.com/client9/cardinal/core"
@@ -13,19 +12,134 @@ import (
 // @ExprSymbol Sqrt
 // @ExprAttributes Listable NumericFunction Protected
 
+// SqrtReal is the floating point square root.  Negative numbers stay
+// unevaluated since complex numbers are not supported.
+//
 // @ExprPattern (_Real)
 func SqrtReal(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	r := args[0].(core.Real)
+	if r.Sign() < 0 {
+		return core.ListFrom(symbol.Sqrt, args[0])
+	}
 	if r.Prec() <= 53 {
 		return core.NewReal(math.Sqrt(r.Float64()))
 	}
-	fmt.Println("SQRT PREC=", r.Prec())
 	return new(big.Float).SetPrec(r.Prec()).Sqrt(r.AsBigFloat())
 }
 
+// SqrtInteger is exact: perfect squares give an Integer, and square
+// factors are pulled out of the rest: Sqrt(12) is Times(2, Sqrt(3))
+// Negative numbers stay unevaluated.
+//
+// @ExprPattern (_Integer)
+func SqrtInteger(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	n := args[0].(core.Integer)
+	if n.Sign() < 0 {
+		return core.ListFrom(symbol.Sqrt, args[0])
+	}
+	k, m := squareFreeSplit(n.AsBigInt())
+	return sqrtProduct(normalizeBigInt(k), m)
+}
+
+// SqrtRational is exact, using Sqrt(p/q) = Sqrt(p*q)/q
+//
+// @ExprPattern (_Rational)
+func SqrtRational(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	r := args[0].(core.Rational)
+	if r.Sign() < 0 {
+		return core.ListFrom(symbol.Sqrt, args[0])
+	}
+	br := r.AsBigRat()
+	k, m := squareFreeSplit(new(big.Int).Mul(br.Num(), br.Denom()))
+	return sqrtProduct(normalizeBigRat(new(big.Rat).SetFrac(k, br.Denom())), m)
+}
+
 // Sqrt is symbolically converted to Power(x, Rational(1,2))
 //
 // @ExprPattern (_)
 func Sqrt(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	return core.ListFrom(symbol.Power, args[0], core.NewRational(1, 2))
 }
+
+// sqrtProduct builds coeff * Sqrt(m), dropping factors of 1.  m is
+// square free so Sqrt(m) is left unevaluated.
+func sqrtProduct(coeff core.Expr, m *big.Int) core.Expr {
+	if m.IsInt64() && m.Int64() == 1 {
+		return coeff
+	}
+	root := core.ListFrom(symbol.Sqrt, normalizeBigInt(m))
+	if i, ok := coeff.(core.Integer); ok && i.IsInt64() && i.Int64() == 1 {
+		return root
+	}
+	return core.ListFrom(symbol.Times, coeff, root)
+}
+
+// squareFactorLimit bounds the trial division used to find square
+// factors of integers that do not fit in a uint64
+const squareFactorLimit = 1 << 16
+
+// squareFreeSplit writes n >= 0 as k^2 * m.  For machine sized n, m is
+// square free.  Larger n only have their small square factors removed.
+func squareFreeSplit(n *big.Int) (k, m *big.Int) {
+	if n.IsUint64() {
+		k, m := squareFreeSplit64(n.Uint64())
+		return new(big.Int).SetUint64(k), new(big.Int).SetUint64(m)
+	}
+
+	k = big.NewInt(1)
+	m = big.NewInt(1)
+	r := new(big.Int).Set(n)
+	q := new(big.Int)
+	rem := new(big.Int)
+	for d := int64(2); d <= squareFactorLimit; d++ {
+		bd := big.NewInt(d)
+		count := 0
+		for {
+			q.QuoRem(r, bd, rem)
+			if rem.Sign() != 0 {
+				break
+			}
+			r.Set(q)
+			count++
+		}
+		for ; count >= 2; count -= 2 {
+			k.Mul(k, bd)
+		}
+		if count == 1 {
+			m.Mul(m, bd)
+		}
+	}
+	if s := new(big.Int).Sqrt(r); new(big.Int).Mul(s, s).Cmp(r) == 0 {
+		k.Mul(k, s)
+	} else {
+		m.Mul(m, r)
+	}
+	return k, m
+}
+
+func squareFreeSplit64(n uint64) (k, m uint64) {
+	k, m = 1, 1
+	// Once d^3 > n, what is left of n has at most two prime factors
+	for d := uint64(2); d <= n/(d*d); d++ {
+		for n%(d*d) == 0 {
+			n /= d * d
+			k *= d
+		}
+		if n%d == 0 {
+			n /= d
+			m *= d
+		}
+	}
+	// correct the float estimate without overflowing s*s
+	s := uint64(math.Sqrt(float64(n)))
+	for s > 0 && s > n/s {
+		s--
+	}
+	for s+1 <= n/(s+1) {
+		s++
+	}
+	if s*s == n {
+		return k * s, m
+	}
+	return k, m * n
+}
@@ -0,0 +1,34 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol StringDrop
+// @ExprAttributes Protected
+
+// StringDrop drops the first n characters of a string, or the last n if n
+// is negative: StringDrop("hello", -2) is "hel"
+// @ExprPattern (_String, _Integer)
+func StringDrop(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return stringDrop(args[0], args[1])
+}
+
+// StringDropSpan drops the nth character, StringDrop(s, [n]), or the
+// characters m through n, StringDrop(s, [m, n]).  Negative positions
+// count from the end.
+// @ExprPattern (_String, [__Integer])
+func StringDropSpan(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return stringDrop(args[0], args[1])
+}
+
+func stringDrop(str core.Expr, spec core.Expr) core.Expr {
+	s, _ := core.ExtractString(str)
+	runes := []rune(s)
+	start, stop, err := stringSpan(len(runes), spec)
+	if err != nil {
+		return err
+	}
+	return core.NewString(string(runes[:start-1]) + string(runes[stop:]))
+}
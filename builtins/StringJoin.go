@@ -0,0 +1,24 @@
+package builtins
+
+import (
+	"strings"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol StringJoin
+// @ExprAttributes Flat OneIdentity Protected
+
+// StringJoin concatenates all of its string arguments.
+// StringJoin() is the empty string.
+//
+// @ExprPattern (___String)
+func StringJoin(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	var sb strings.Builder
+	for _, arg := range args {
+		s, _ := core.ExtractString(arg)
+		sb.WriteString(s)
+	}
+	return core.NewString(sb.String())
+}
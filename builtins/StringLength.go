@@ -8,6 +8,7 @@ import (
 )
 
 // @ExprSymbol StringLength
+// @ExprAttributes Protected
 
 // StringLengthRunes returns the UTF-8 rune count of a string
 // @ExprPattern (_String)
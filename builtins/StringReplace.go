@@ -0,0 +1,47 @@
+package builtins
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol StringReplace
+// @ExprAttributes Protected
+
+// StringReplace replaces literal substrings using a rule or list of rules:
+// StringReplace("abc", ["a": "x", "b": "y"])
+// The string is scanned once from the left.  At each position the rules
+// are tried in order, and replaced text is never matched again.
+//
+// @ExprPattern (_String, _)
+func StringReplace(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	s, _ := core.ExtractString(args[0])
+
+	rules := []core.Expr{args[1]}
+	if args[1].Head() == symbol.List {
+		rules = args[1].(core.List).Tail()
+	}
+	oldnew := make([]string, 0, 2*len(rules))
+	for _, rule := range rules {
+		ruleList, ok := rule.(core.List)
+		if !ok || ruleList.Head() != symbol.Rule || ruleList.Length() != 2 {
+			return core.NewError("ArgumentError",
+				fmt.Sprintf("StringReplace expected string rules, got %s", rule.String()))
+		}
+		from, ok1 := core.ExtractString(ruleList.Tail()[0])
+		to, ok2 := core.ExtractString(ruleList.Tail()[1])
+		if !ok1 || !ok2 || from == "" {
+			return core.NewError("ArgumentError",
+				fmt.Sprintf("StringReplace expected non-empty string rules, got %s", rule.String()))
+		}
+		oldnew = append(oldnew, from, to)
+	}
+
+	// strings.Replacer does a single non-overlapping pass, trying
+	// the pairs in argument order
+	return core.NewString(strings.NewReplacer(oldnew...).Replace(s))
+}
@@ -0,0 +1,39 @@
+package builtins
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol StringRiffle
+// @ExprAttributes Protected
+
+// StringRiffle joins a list of strings with a space between each
+// @ExprPattern (_List)
+func StringRiffle(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return stringRiffle(args[0].(core.List), " ")
+}
+
+// StringRiffleSeparator joins a list of strings with a separator, the
+// inverse of StringSplit(s, sep)
+// @ExprPattern (_List, _String)
+func StringRiffleSeparator(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	sep, _ := core.ExtractString(args[1])
+	return stringRiffle(args[0].(core.List), sep)
+}
+
+func stringRiffle(list core.List, sep string) core.Expr {
+	fields := make([]string, 0, list.Length())
+	for _, arg := range list.Tail() {
+		s, ok := core.ExtractString(arg)
+		if !ok {
+			return core.NewError("TypeError",
+				fmt.Sprintf("StringRiffle expected a list of strings, got %s", arg.String()))
+		}
+		fields = append(fields, s)
+	}
+	return core.NewString(strings.Join(fields, sep))
+}
@@ -0,0 +1,42 @@
+package builtins
+
+import (
+	"strings"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol StringSplit
+// @ExprAttributes Protected
+
+// StringSplit splits a string on runs of whitespace, so no field is empty
+// @ExprPattern (_String)
+func StringSplit(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	s, _ := core.ExtractString(args[0])
+	return stringList(strings.Fields(s))
+}
+
+// StringSplitSeparator splits a string on a literal separator.
+// Consecutive separators give empty fields:
+// StringSplit("a,,b", ",") is ["a", "", "b"]
+//
+// @ExprPattern (_String, _String)
+func StringSplitSeparator(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	s, _ := core.ExtractString(args[0])
+	sep, _ := core.ExtractString(args[1])
+	if s == "" {
+		return stringList(nil)
+	}
+	return stringList(strings.Split(s, sep))
+}
+
+// stringList converts a slice of Go strings to a List of Strings
+func stringList(fields []string) core.Expr {
+	out := make([]core.Expr, len(fields))
+	for i, f := range fields {
+		out[i] = core.NewString(f)
+	}
+	return core.ListFrom(symbol.List, out...)
+}
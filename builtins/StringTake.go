@@ -0,0 +1,106 @@
+package builtins
+
+import (
+	"fmt"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol StringTake
+// @ExprAttributes Protected
+
+// StringTake takes the first n characters of a string, or the last n if n
+// is negative: StringTake("hello", -2) is "lo"
+// @ExprPattern (_String, _Integer)
+func StringTake(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return stringTake(args[0], args[1])
+}
+
+// StringTakeSpan takes the nth character, StringTake(s, [n]), or the
+// characters m through n, StringTake(s, [m, n]).  Negative positions
+// count from the end.
+// @ExprPattern (_String, [__Integer])
+func StringTakeSpan(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return stringTake(args[0], args[1])
+}
+
+func stringTake(str core.Expr, spec core.Expr) core.Expr {
+	s, _ := core.ExtractString(str)
+	runes := []rune(s)
+	start, stop, err := stringSpan(len(runes), spec)
+	if err != nil {
+		return err
+	}
+	return core.NewString(string(runes[start-1 : stop]))
+}
+
+// spanPosition returns the value of an Integer position that fits in an
+// int64.  core.ExtractInt64 would truncate a larger one.
+func spanPosition(e core.Expr) (int64, bool) {
+	i, ok := e.(core.Integer)
+	if !ok || !i.IsInt64() {
+		return 0, false
+	}
+	return i.Int64(), true
+}
+
+// stringSpan converts n, [n] or [m, n] into the 1-based inclusive span
+// [start, stop] of a string of length characters.  An empty span has
+// stop == start - 1.
+func stringSpan(length int, spec core.Expr) (int, int, core.Expr) {
+	// position converts a negative position to count from the end
+	position := func(n int64) int {
+		if n < 0 {
+			return length + int(n) + 1
+		}
+		return int(n)
+	}
+
+	var start, stop int
+	if _, ok := spec.(core.List); !ok {
+		// an Integer too large for int64 is past the end either way
+		n, ok := spanPosition(spec)
+		if !ok {
+			return 0, 0, stringPartError(length, spec)
+		}
+		if n >= 0 {
+			start, stop = 1, int(n)
+		} else {
+			start, stop = length+int(n)+1, length
+		}
+	} else {
+		bounds := spec.(core.List).Tail()
+		for _, b := range bounds {
+			if _, ok := spanPosition(b); !ok {
+				return 0, 0, stringPartError(length, spec)
+			}
+		}
+		switch len(bounds) {
+		case 1:
+			n, _ := core.ExtractInt64(bounds[0])
+			start = position(n)
+			stop = start
+			if start < 1 || start > length {
+				return 0, 0, stringPartError(length, spec)
+			}
+		case 2:
+			m, _ := core.ExtractInt64(bounds[0])
+			n, _ := core.ExtractInt64(bounds[1])
+			start, stop = position(m), position(n)
+		default:
+			return 0, 0, core.NewError("ArgumentError",
+				fmt.Sprintf("string span %s must have one or two positions", spec.String()))
+		}
+	}
+
+	if start < 1 || stop > length || stop < start-1 {
+		return 0, 0, stringPartError(length, spec)
+	}
+	return start, stop, nil
+}
+
+func stringPartError(length int, spec core.Expr) core.Expr {
+	return core.NewError("StringPartError",
+		fmt.Sprintf("cannot take %s of a string of length %d", spec.String(), length))
+}
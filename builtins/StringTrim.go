@@ -0,0 +1,29 @@
+package builtins
+
+import (
+	"strings"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol StringTrim
+// @ExprAttributes Protected
+
+// StringTrim removes leading and trailing whitespace from a string
+// @ExprPattern (_String)
+func StringTrim(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	s, _ := core.ExtractString(args[0])
+	return core.NewString(strings.TrimSpace(s))
+}
+
+// StringTrimLiteral removes a literal string once from the start and once
+// from the end: StringTrim("--a--", "-") is "-a-"
+// @ExprPattern (_String, _String)
+func StringTrimLiteral(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	s, _ := core.ExtractString(args[0])
+	affix, _ := core.ExtractString(args[1])
+	s = strings.TrimPrefix(s, affix)
+	s = strings.TrimSuffix(s, affix)
+	return core.NewString(s)
+}
@@ -0,0 +1,65 @@
+package builtins
+
+import (
+	"fmt"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Sum
+// @ExprAttributes HoldAll Protected
+
+// Sum adds up expr over an iterator, using the same iterator
+// specification as Table: Sum(i, [i, 1, 10]) is 55.  The terms are
+// combined with Plus so integer and rational sums stay exact.
+//
+// @ExprPattern (_,_)
+func Sum(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	terms, err := iteratorTerms(e, c, "Sum", args[0], args[1])
+	if err != nil {
+		return err
+	}
+	if len(terms) == 0 {
+		return core.NewInteger(0)
+	}
+	return e.Evaluate(core.ListFrom(symbol.Plus, terms...))
+}
+
+// iteratorTerms evaluates expr for each value of the iterator spec and
+// returns the results in order
+func iteratorTerms(e *engine.Evaluator, c *engine.Context, name string, expr core.Expr, spec core.Expr) ([]core.Expr, core.Expr) {
+	iterSpec, ok := spec.(core.List)
+	if !ok || iterSpec.Head() != symbol.List {
+		return nil, core.NewError("ArgumentError", name+" second argument must be an iterator List(var, start, end, step)")
+	}
+	variable, start, end, increment, err := parseTableIteratorSpec(e, c, iterSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	var terms []core.Expr
+	current := start
+	limit := c.IterationLimit() // Prevent infinite loops
+
+	for iteration := 0; ; iteration++ {
+		if !evaluateIteratorCondition(e, c, current, end, increment) {
+			break
+		}
+		if limit > 0 && iteration >= limit {
+			return nil, core.NewError("IterationLimitError", fmt.Sprintf("iteration limit of %d exceeded", limit))
+		}
+		term := evaluateWithIteratorBinding(e, c, expr, variable, current)
+		if core.IsError(term) {
+			return nil, term
+		}
+		terms = append(terms, term)
+
+		current = evaluateIteratorIncrement(e, c, current, increment)
+		if core.IsError(current) {
+			return nil, current
+		}
+	}
+	return terms, nil
+}
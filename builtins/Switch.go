@@ -0,0 +1,34 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Switch
+// @ExprAttributes HoldRest Protected
+
+// Switch returns the value paired with the first pattern that expr
+// matches:
+// Switch(x, _Integer, "integer", _String, "string", _, "other")
+// If no pattern matches, Switch is returned unevaluated.
+//
+// @ExprPattern (_, ___)
+func Switch(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	expr := args[0]
+	cases := args[1:]
+	if len(cases) == 0 || len(cases)%2 != 0 {
+		return core.NewError("ArgumentError", "Switch expects an expression followed by pattern and value pairs")
+	}
+	for i := 0; i < len(cases); i += 2 {
+		pattern := e.Evaluate(cases[i])
+		if core.IsError(pattern) {
+			return pattern
+		}
+		if ok, _ := e.Match(expr, pattern); ok {
+			return e.Evaluate(cases[i+1])
+		}
+	}
+	return core.ListFrom(symbol.Switch, args...)
+}
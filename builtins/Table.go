@@ -10,12 +10,21 @@ import (
 // @ExprSymbol Table
 // @ExprAttributes HoldAll
 
-// Table ...
-// @ExprPattern (_,_)
+// Table builds a list by evaluating expr for each value of an iterator.
+// With several iterators the result is nested, with later iterators
+// varying fastest.  Inner iterators can depend on outer ones:
+// Table(i*j, [i, 1, 3], [j, 1, i])
+//
+// @ExprPattern (_,__)
 func Table(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	expr := args[0] // Don't evaluate expr yet - Table has HoldAll
 	spec := args[1] // Don't evaluate spec yet
 
+	// The inner iterators become a Table evaluated for each outer value
+	if len(args) > 2 {
+		expr = core.ListFrom(symbol.Table, append([]core.Expr{expr}, args[2:]...)...)
+	}
+
 	// if list, assume iterator spec
 	if list, ok := spec.(core.List); ok && list.Head() == symbol.List {
 		return tableIterator(e, c, expr, list)
@@ -75,14 +84,17 @@ func tableIterator(e *engine.Evaluator, c *engine.Context, expr core.Expr, iterS
 	var results []core.Expr
 
 	current := start
-	const maxIterations = 10000 // Prevent infinite loops
+	limit := c.IterationLimit() // Prevent infinite loops
 
-	for iteration := 0; iteration < maxIterations; iteration++ {
+	for iteration := 0; ; iteration++ {
 		// Check if we should continue iterating
 		shouldContinue := evaluateIteratorCondition(e, c, current, end, increment)
 		if !shouldContinue {
 			break
 		}
+		if limit > 0 && iteration >= limit {
+			return core.NewError("IterationLimitError", fmt.Sprintf("iteration limit of %d exceeded", limit))
+		}
 
 		// Use Block to bind iterator variable and evaluate expression
 		blockResult := evaluateWithIteratorBinding(e, c, expr, variable, current)
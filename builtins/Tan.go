@@ -5,6 +5,7 @@ import (
 
 	"github.com/client9/cardinal/core"
 	"github.com/client9/cardinal/core/big"
+	"github.com/client9/cardinal/core/symbol"
 	"github.com/client9/cardinal/engine"
 )
 
@@ -19,3 +20,15 @@ func Tan(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	}
 	return new(big.Float).SetPrec(r.Prec()).Tan(r.AsBigFloat())
 }
+
+// TanExact is 0 at multiples of Pi.  The poles at odd multiples of
+// Pi/2 stay unevaluated.
+//
+// @ExprPattern (_)
+func TanExact(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	k, ok := halfPiMultiple(args[0])
+	if !ok || k%2 == 1 {
+		return core.ListFrom(symbol.Tan, args[0])
+	}
+	return core.NewInteger(0)
+}
@@ -0,0 +1,54 @@
+package builtins
+
+import (
+	"fmt"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Thread
+// @ExprAttributes Protected
+
+// Thread distributes a head over the List arguments of an expression:
+// Thread(f([1, 2], [3, 4])) is [f(1, 3), f(2, 4)].  Arguments that are not
+// Lists are repeated in every element.  The Lists must have equal length.
+//
+// @ExprPattern (_)
+func Thread(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	expr, ok := args[0].(core.List)
+	if !ok {
+		return args[0]
+	}
+
+	length := int64(-1)
+	for _, arg := range expr.Tail() {
+		list, ok := arg.(core.List)
+		if !ok || list.Head() != symbol.List {
+			continue
+		}
+		if length == -1 {
+			length = list.Length()
+		} else if list.Length() != length {
+			return core.NewError("LengthMismatch",
+				fmt.Sprintf("Thread lists have lengths %d and %d", length, list.Length()))
+		}
+	}
+	if length == -1 {
+		return expr
+	}
+
+	result := make([]core.Expr, length)
+	for i := range result {
+		parts := make([]core.Expr, expr.Length())
+		for j, arg := range expr.Tail() {
+			parts[j] = arg
+			if list, ok := arg.(core.List); ok && list.Head() == symbol.List {
+				parts[j] = list.Tail()[i]
+			}
+		}
+		result[i] = e.Evaluate(core.ListFrom(expr.Head(), parts...))
+	}
+	return core.ListFrom(symbol.List, result...)
+}
@@ -0,0 +1,26 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Throw
+// @ExprAttributes Protected
+
+// Throw stops the evaluation and returns value from the nearest
+// enclosing Catch:
+// Catch(Map(If($ > 2, Throw($), $) &, [1, 2, 3, 4]))
+//
+// @ExprPattern (_)
+func Throw(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return core.NewThrow(args[0], nil)
+}
+
+// ThrowTag throws value with a tag, which is only caught by a Catch
+// whose form matches the tag: Catch(Throw(1, "done"), "done")
+//
+// @ExprPattern (_, _)
+func ThrowTag(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return core.NewThrow(args[0], args[1])
+}
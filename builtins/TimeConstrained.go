@@ -0,0 +1,41 @@
+package builtins
+
+import (
+	"math"
+	"time"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol TimeConstrained
+// @ExprAttributes HoldAll Protected
+
+// aborted is the result of an evaluation that was stopped
+var aborted = core.NewSymbol("$Aborted")
+
+// TimeConstrained evaluates expr, and returns $Aborted if it takes
+// longer than the given number of seconds:
+// TimeConstrained(While(True, 1), 0.5) is $Aborted
+//
+// @ExprPattern (_, _)
+func TimeConstrained(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	seconds, ok := core.GetNumericValue(e.Evaluate(args[1]))
+	if !ok || seconds <= 0 {
+		return core.NewError("ArgumentError", "TimeConstrained requires a positive number of seconds")
+	}
+	result, timedOut := e.EvaluateWithin(args[0], secondsDuration(seconds))
+	if timedOut {
+		return aborted
+	}
+	return result
+}
+
+// secondsDuration converts seconds to a Duration, the longest Duration if
+// there are too many seconds for one
+func secondsDuration(seconds float64) time.Duration {
+	if seconds >= float64(math.MaxInt64)/float64(time.Second) {
+		return math.MaxInt64
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
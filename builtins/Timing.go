@@ -1,20 +1,32 @@
 package builtins
 
 import (
+	"time"
+
 	"github.com/client9/cardinal/core"
 	"github.com/client9/cardinal/core/symbol"
 	"github.com/client9/cardinal/engine"
-	"time"
 )
 
 // @ExprSymbol Timing
-// @ExprAttributes HoldAll
-//
+// @ExprAttributes HoldAll Protected
 
+// Timing evaluates expr once and returns [seconds, result], where
+// seconds is the time taken by the evaluation: Timing(1 + 1) is
+// [0.0000012, 2].  There is no portable measure of CPU time, so this
+// is the same as AbsoluteTiming.
+//
 // @ExprPattern (_)
 func Timing(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return timeEvaluation(e, args[0])
+}
+
+func timeEvaluation(e *engine.Evaluator, expr core.Expr) core.Expr {
 	start := time.Now()
-	result := EvaluateExpr(e, c, args)
+	result := e.Evaluate(expr)
 	elapsed := time.Since(start)
-	return core.NewList(symbol.List, result, core.NewReal(float64(elapsed)/1.0e9))
+	if core.IsError(result) {
+		return result
+	}
+	return core.ListFrom(symbol.List, core.NewReal(elapsed.Seconds()), result)
 }
@@ -0,0 +1,47 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol ToExpression
+// @ExprAttributes Protected
+
+// ToExpression parses a string and evaluates the result:
+// ToExpression("1 + 2") is 3
+// @ExprPattern (_String)
+func ToExpression(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	expr, err := parseExpression(e, args[0])
+	if err != nil {
+		return err
+	}
+	return e.Evaluate(expr)
+}
+
+// ToExpressionHold parses a string and wraps the result in Hold
+// without evaluating it: ToExpression("1 + 2", Hold) is Hold(1 + 2)
+// @ExprPattern (_String, _)
+func ToExpressionHold(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if args[1] != symbol.Hold {
+		return core.NewError("ArgumentError",
+			"ToExpression wrapper must be Hold, got "+args[1].String())
+	}
+	expr, err := parseExpression(e, args[0])
+	if err != nil {
+		return err
+	}
+	return core.NewList(symbol.Hold, expr)
+}
+
+// parseExpression parses a String with the evaluator's operator table,
+// returning a SyntaxError on failure
+func parseExpression(e *engine.Evaluator, s core.Expr) (core.Expr, core.Expr) {
+	str, _ := core.ExtractString(s)
+	expr, err := e.ParseString(str)
+	if err != nil {
+		return nil, core.NewError("SyntaxError", err.Error())
+	}
+	return expr, nil
+}
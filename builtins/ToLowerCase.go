@@ -0,0 +1,18 @@
+package builtins
+
+import (
+	"strings"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol ToLowerCase
+// @ExprAttributes Protected
+
+// ToLowerCase converts all letters of a string to lower case
+// @ExprPattern (_String)
+func ToLowerCase(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	s, _ := core.ExtractString(args[0])
+	return core.NewString(strings.ToLower(s))
+}
@@ -0,0 +1,39 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol ToString
+// @ExprAttributes Protected
+
+// ToString returns the OutputForm of an expression as a string.
+// Strings are returned as-is, without quotes.
+// @ExprPattern (_)
+func ToString(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return outputFormString(args[0])
+}
+
+// ToStringForm returns the string of an expression in the given form,
+// either InputForm or OutputForm: ToString(f(x) + 1, InputForm)
+// InputForm output can be read back by the parser.
+// @ExprPattern (_, _)
+func ToStringForm(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	switch args[1] {
+	case symbol.InputForm:
+		return core.NewString(args[0].InputForm())
+	case symbol.OutputForm:
+		return outputFormString(args[0])
+	}
+	return core.NewError("ArgumentError",
+		"ToString form must be InputForm or OutputForm, got "+args[1].String())
+}
+
+func outputFormString(expr core.Expr) core.Expr {
+	if s, ok := expr.(core.String); ok {
+		return s
+	}
+	return core.NewString(expr.String())
+}
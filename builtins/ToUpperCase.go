@@ -0,0 +1,18 @@
+package builtins
+
+import (
+	"strings"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol ToUpperCase
+// @ExprAttributes Protected
+
+// ToUpperCase converts all letters of a string to upper case
+// @ExprPattern (_String)
+func ToUpperCase(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	s, _ := core.ExtractString(args[0])
+	return core.NewString(strings.ToUpper(s))
+}
@@ -0,0 +1,16 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Total
+// @ExprAttributes Protected
+
+// Total returns the sum of the elements of a list: Total([1, 2, 3]) is 6
+// @ExprPattern (_List)
+func Total(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return e.Evaluate(core.ListFrom(symbol.Plus, args[0].(core.List).Tail()...))
+}
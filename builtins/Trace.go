@@ -0,0 +1,54 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Trace
+// @ExprAttributes HoldAll Protected
+
+// Trace evaluates expr and returns a nested list of all the intermediate
+// expressions, each wrapped in Hold:
+// Trace(2 + 3 * 4)
+// [Hold(2 + 3 * 4), [Hold(3 * 4), Hold(12)], Hold(2 + 12), Hold(14)]
+//
+// @ExprPattern (_)
+func Trace(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return e.Trace(args[0])
+}
+
+// TraceForm only keeps the steps that match form.  If form is a symbol,
+// the steps with that head are kept: Trace(2 + 3 * 4, Times)
+//
+// @ExprPattern (_, _)
+func TraceForm(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	form := args[1]
+	steps := e.Trace(args[0])
+	return filterTrace(e, steps, func(x core.Expr) bool {
+		if _, ok := form.(core.Symbol); ok {
+			return x.Head() == form
+		}
+		ok, _ := e.Match(x, form)
+		return ok
+	})
+}
+
+// filterTrace keeps the steps that match, and drops lists that end up
+// empty
+func filterTrace(e *engine.Evaluator, steps core.List, keep func(core.Expr) bool) core.List {
+	var out []core.Expr
+	for _, step := range steps.Tail() {
+		if step.Head() == symbol.Hold {
+			if keep(step.(core.List).Tail()[0]) {
+				out = append(out, step)
+			}
+			continue
+		}
+		if sub := filterTrace(e, step.(core.List), keep); sub.Length() > 0 {
+			out = append(out, sub)
+		}
+	}
+	return core.ListFrom(symbol.List, out...)
+}
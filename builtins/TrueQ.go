@@ -7,8 +7,11 @@ import (
 )
 
 // @ExprSymbol TrueQ
+// @ExprAttributes Protected
 
-// TrueQ check is an expression is explicity True
+// TrueQ checks if an expression is explicitly True.  Anything else,
+// including unbound symbols, is False.
+//
 // @ExprPattern (_)
 func TrueQ(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	return core.NewBool(args[0] == symbol.True)
@@ -0,0 +1,9 @@
+package builtins
+
+// @ExprSymbol Unevaluated
+// @ExprAttributes HoldAll Protected
+//
+// Unevaluated(expr) passes expr to a function without evaluating it,
+// and then disappears: Length(Unevaluated(1 + 1)) is 2, the Length of
+// Plus(1, 1).  If no definition of the function matches, the wrapper
+// is kept.
@@ -4,7 +4,6 @@ import (
 	"github.com/client9/cardinal/core"
 	"github.com/client9/cardinal/engine"
 
-	"slices"
 	"sort"
 )
 
@@ -22,8 +21,14 @@ func Union(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 
 	union := make([]core.Expr, 0, n)
 
+	// Drop duplicates first, so only distinct elements are sorted
+	seen := core.NewExprSet()
 	for _, a := range args {
-		union = append(union, a.(core.List).Tail()...)
+		for _, elem := range a.(core.List).Tail() {
+			if seen.Add(elem) {
+				union = append(union, elem)
+			}
+		}
 	}
 
 	// canoncialcompare has wrong signature
@@ -33,8 +38,5 @@ func Union(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
 	sort.Slice(union, func(i, j int) bool {
 		return core.CanonicalCompare(union[i], union[j])
 	})
-	union = slices.CompactFunc(union, func(a core.Expr, b core.Expr) bool {
-		return a.Equal(b)
-	})
 	return core.ListFrom(args[0].Head(), union...)
 }
@@ -0,0 +1,24 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol UpSet
+// @ExprAttributes HoldFirst Protected SequenceHold
+
+// UpSet evaluates rhs and defines it as an up-value of the heads of the
+// arguments of lhs: radius(Circle(r_)) ^= ... or area(sq) ^= 4
+//
+// @ExprPattern (_, _)
+func UpSet(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	rhs := args[1]
+	if core.IsError(rhs) {
+		return rhs
+	}
+	if err := defineUpValue(c, args[0], rhs); err != nil {
+		return err
+	}
+	return rhs
+}
@@ -0,0 +1,73 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol UpSetDelayed
+// @ExprAttributes HoldAll Protected SequenceHold
+
+// UpSetDelayed defines lhs := rhs as an up-value, attached to the heads
+// of the arguments of lhs instead of to the head of lhs:
+// area(c_Circle) ^:= Pi * radius(c)^2
+// The definition is used when no ordinary definition of area matches.
+//
+// @ExprPattern (_, _)
+func UpSetDelayed(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if err := defineUpValue(c, args[0], args[1]); err != nil {
+		return err
+	}
+	return symbol.Null
+}
+
+// defineUpValue attaches lhs := rhs to each head in the arguments of lhs
+func defineUpValue(c *engine.Context, lhs core.Expr, rhs core.Expr) core.Expr {
+	if lhs.Head() == symbol.HoldPattern && lhs.Length() == 1 {
+		lhs = lhs.(core.List).Tail()[0]
+	}
+	list, ok := lhs.(core.List)
+	if !ok || list.Length() == 0 {
+		return core.NewError("ArgumentError", "up-value definitions need a function call on the left-hand side")
+	}
+
+	var tags []core.Symbol
+	for _, arg := range list.Tail() {
+		if tag, ok := upValueTag(arg); ok {
+			tags = append(tags, tag)
+		}
+	}
+	if len(tags) == 0 {
+		return core.NewError("ArgumentError", "no symbol to attach the up-value to in "+lhs.String())
+	}
+
+	symbolTable := c.GetSymbolTable()
+	for _, tag := range tags {
+		if symbolTable.HasAttribute(tag, engine.Protected) {
+			return core.NewError("Protected", "symbol "+tag.String()+" is Protected")
+		}
+	}
+	registry := c.GetFunctionRegistry()
+	for _, tag := range tags {
+		registry.RegisterUpValue(tag, lhs, rhs)
+	}
+	return nil
+}
+
+// upValueTag returns the symbol an argument pattern is attached to:
+// the symbol itself, the head of a call, or the head in c_Circle
+func upValueTag(arg core.Expr) (core.Symbol, bool) {
+	if _, name, p := core.IsSymbolicPattern(arg); name != nil {
+		arg = p
+	}
+	if isBlank, _, head := core.IsSymbolicBlank(arg); isBlank {
+		tag, ok := head.(core.Symbol)
+		return tag, ok
+	}
+	if tag, ok := arg.(core.Symbol); ok {
+		return tag, true
+	}
+	tag, ok := arg.Head().(core.Symbol)
+	return tag, ok
+}
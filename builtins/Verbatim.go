@@ -0,0 +1,7 @@
+package builtins
+
+// @ExprSymbol Verbatim
+// @ExprAttributes Protected
+//
+// Verbatim(p) matches p literally, so patterns can be matched as data.
+// MatchQ(x_, Verbatim(x_)) is True, but MatchQ(5, Verbatim(x_)) is False.
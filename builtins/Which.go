@@ -0,0 +1,32 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Which
+// @ExprAttributes HoldAll Protected
+
+// Which evaluates the conditions in turn and returns the value paired
+// with the first one that is True:
+// Which(x < 0, "negative", x == 0, "zero", True, "positive")
+// If no condition is True, the result is Null.
+//
+// @ExprPattern (___)
+func Which(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	if len(args)%2 != 0 {
+		return core.NewError("ArgumentError", "Which expects an even number of arguments")
+	}
+	for i := 0; i < len(args); i += 2 {
+		condition := e.Evaluate(args[i])
+		if core.IsError(condition) {
+			return condition
+		}
+		if condition == symbol.True {
+			return e.Evaluate(args[i+1])
+		}
+	}
+	return symbol.Null
+}
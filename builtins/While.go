@@ -0,0 +1,51 @@
+package builtins
+
+import (
+	"fmt"
+
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol While
+// @ExprAttributes HoldAll Protected
+
+// While evaluates body repeatedly while test is True, and returns Null:
+// i = 0; While(i < 10, i = i + 1)
+//
+// @ExprPattern (_, _)
+func While(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return loop(e, c, args[0], symbol.Null, args[1])
+}
+
+// WhileTest evaluates test until it is no longer True: While(next())
+//
+// @ExprPattern (_)
+func WhileTest(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	return loop(e, c, args[0], symbol.Null, symbol.Null)
+}
+
+// loop runs body then incr while test is True, up to the context's
+// iteration limit.  It is shared by While and For.
+func loop(e *engine.Evaluator, c *engine.Context, test, incr, body core.Expr) core.Expr {
+	limit := c.IterationLimit()
+	for i := 0; ; i++ {
+		cond := e.Evaluate(test)
+		if core.IsError(cond) {
+			return cond
+		}
+		if cond != symbol.True {
+			return symbol.Null
+		}
+		if limit > 0 && i >= limit {
+			return core.NewError("IterationLimitError", fmt.Sprintf("iteration limit of %d exceeded", limit))
+		}
+		if result := e.Evaluate(body); core.IsError(result) {
+			return result
+		}
+		if result := e.Evaluate(incr); core.IsError(result) {
+			return result
+		}
+	}
+}
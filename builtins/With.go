@@ -9,35 +9,115 @@ import (
 // @ExprSymbol With
 // @ExprAttributes HoldAll
 
-// @ExprPattern (_,_)
+// With evaluates body after replacing each local by its value:
+// With([x = 1, y = 2], body)
+// The values are evaluated first, then inserted directly into the held
+// body, so no symbols are left assigned afterwards.  A nested With,
+// Module or Function that has its own local of the same name shadows
+// the outer one.
+//
+// @ExprPattern (_List, _)
 func With(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
-
-	vars := args[0]
+	vars := args[0].(core.List)
 	body := args[1]
 
-	// we are assuming the vars are all [ x=x0, y=y0, ], i.e List( Set(x,x0), Set(y,y0) ...)
-	// Replace functions expect a list of Rule
-	// Copy List of Sets, and change heads to Rule
-	// Call ReplaceAll in stdlib (purely mechanical change, not the ReplaceAll in Builts
-
-	list, ok := vars.(core.List)
-	if !ok {
-		return core.NewError("ArgumentError", "With expected list for first argument")
-	}
-	rules := list.Copy()
-	for _, arg := range rules.Tail() {
-		r, ok := arg.(core.List)
-		if !ok || r.Head() != symbol.Set || r.Length() != 2 {
+	values := make(map[core.Symbol]core.Expr, vars.Length())
+	for _, arg := range vars.Tail() {
+		set, ok := arg.(core.List)
+		if !ok || set.Head() != symbol.Set || set.Length() != 2 {
 			return core.NewError("ArgumentError", "With expected list of set assignments")
 		}
-		// TODO: DANGER
-		r.SetHead("Rule")
-		//(*r.Elements)[0] = symbol.Rule)
+		name, ok := set.Tail()[0].(core.Symbol)
+		if !ok {
+			return core.NewError("ArgumentError", "With variables must be symbols")
+		}
+		value := e.Evaluate(set.Tail()[1])
+		if core.IsError(value) {
+			return value
+		}
+		values[name] = value
 	}
 
-	modified := core.ReplaceAllWithRules(body, rules)
+	return e.Evaluate(withReplace(body, values))
+}
 
-	result := e.Evaluate(modified)
+// withReplace replaces the symbols in values throughout expr, except
+// inside a scoping construct that binds the same symbol
+func withReplace(expr core.Expr, values map[core.Symbol]core.Expr) core.Expr {
+	switch ex := expr.(type) {
+	case core.Symbol:
+		if value, ok := values[ex]; ok {
+			return value
+		}
+		return ex
+	case core.List:
+		if inner := scopedLocals(ex); len(inner) > 0 {
+			return withReplaceScoped(ex, values, withoutLocals(values, inner))
+		}
+		elements := ex.AsSlice()
+		out := make([]core.Expr, len(elements))
+		for i, element := range elements {
+			out[i] = withReplace(element, values)
+		}
+		return core.NewListFromExprs(out...)
+	default:
+		return expr
+	}
+}
 
-	return result
+// withReplaceScoped replaces in a scoping construct.  Initial values of
+// the locals still see the outer values, but the body does not.
+func withReplaceScoped(list core.List, outer, inner map[core.Symbol]core.Expr) core.Expr {
+	args := list.Tail()
+	spec := args[0]
+	if specList, ok := spec.(core.List); ok {
+		vars := make([]core.Expr, 0, specList.Length())
+		for _, v := range specList.Tail() {
+			if set, ok := v.(core.List); ok && set.Head() == symbol.Set && set.Length() == 2 {
+				v = core.ListFrom(symbol.Set, set.Tail()[0], withReplace(set.Tail()[1], outer))
+			}
+			vars = append(vars, v)
+		}
+		spec = core.ListFrom(specList.Head(), vars...)
+	}
+	return core.ListFrom(list.Head(), spec, withReplace(args[1], inner))
+}
+
+// scopedLocals returns the locals of With([x = 1], ...), Module([x], ...)
+// and Function(x, ...) or Function([x, y], ...)
+func scopedLocals(list core.List) []core.Symbol {
+	head := list.Head()
+	if list.Length() != 2 || (head != symbol.With && head != symbol.Module && head != symbol.Function) {
+		return nil
+	}
+	spec := list.Tail()[0]
+	if sym, ok := spec.(core.Symbol); ok && head == symbol.Function {
+		return []core.Symbol{sym}
+	}
+	specList, ok := spec.(core.List)
+	if !ok || specList.Head() != symbol.List {
+		return nil
+	}
+	var locals []core.Symbol
+	for _, v := range specList.Tail() {
+		if v.Head() == symbol.Set && v.Length() == 2 {
+			v = v.(core.List).Tail()[0]
+		}
+		if sym, ok := v.(core.Symbol); ok {
+			locals = append(locals, sym)
+		}
+	}
+	return locals
+}
+
+// withoutLocals returns values without the shadowed symbols
+func withoutLocals(values map[core.Symbol]core.Expr, locals []core.Symbol) map[core.Symbol]core.Expr {
+	out := make(map[core.Symbol]core.Expr, len(values))
+	for k, v := range values {
+		out[k] = v
+	}
+	for _, sym := range locals {
+		delete(out, sym)
+	}
+	return out
 }
@@ -0,0 +1,42 @@
+package builtins
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+	"github.com/client9/cardinal/engine"
+)
+
+// @ExprSymbol Xor
+// @ExprAttributes Orderless Protected
+
+// Xor is True if an odd number of its arguments are True.  All
+// arguments are needed so there is no short-circuiting.  Non-boolean
+// arguments are kept: Xor(True, x) is Not(x).
+//
+// @ExprPattern (___)
+func Xor(e *engine.Evaluator, c *engine.Context, args []core.Expr) core.Expr {
+	odd := false
+	var nonBooleanArgs []core.Expr
+	for _, arg := range args {
+		val, ok := core.ExtractBool(arg)
+		if !ok {
+			nonBooleanArgs = append(nonBooleanArgs, arg)
+			continue
+		}
+		odd = odd != val
+	}
+
+	var result core.Expr
+	switch len(nonBooleanArgs) {
+	case 0:
+		return core.NewBool(odd)
+	case 1:
+		result = nonBooleanArgs[0]
+	default:
+		result = core.ListFrom(symbol.Xor, nonBooleanArgs...)
+	}
+	if odd {
+		return core.ListFrom(symbol.Not, result)
+	}
+	return result
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+)
+
+// history is the list of expressions entered in the REPL, oldest first.
+// When it has a file, each entry is appended to it as it is added, one
+// Go-quoted entry per line so multi-line expressions are kept whole.
+type history struct {
+	entries []string
+	file    string
+}
+
+// load reads the entries saved in file, and appends new entries to it.
+// A missing file is the same as an empty one.
+func (h *history) load(file string) error {
+	h.file = file
+	f, err := os.Open(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		entry, err := strconv.Unquote(scanner.Text())
+		if err != nil {
+			// a line written by something else
+			continue
+		}
+		h.entries = append(h.entries, entry)
+	}
+	return scanner.Err()
+}
+
+// add appends entry to the history and its file
+func (h *history) add(entry string) error {
+	h.entries = append(h.entries, entry)
+	if h.file == "" {
+		return nil
+	}
+	f, err := os.OpenFile(h.file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(f, strconv.Quote(entry)); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// get returns entry n, counting from 1
+func (h *history) get(n int) (string, bool) {
+	if n < 1 || n > len(h.entries) {
+		return "", false
+	}
+	return h.entries[n-1], true
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestREPLHistory(t *testing.T) {
+	input := strings.NewReader("x = 1\nx = x + 1\n:history\n!2\nx\n")
+	output := &bytes.Buffer{}
+
+	repl := NewREPLWithIO(input, output)
+	if err := repl.Run(); err != nil {
+		t.Fatalf("REPL error: %v", err)
+	}
+
+	// the rerun entry is recorded, not the !n command
+	expected := []string{"x = 1", "x = x + 1", "x = x + 1", "x"}
+	if got := repl.history.entries; strings.Join(got, "|") != strings.Join(expected, "|") {
+		t.Errorf("Expected history %q, got %q", expected, got)
+	}
+	if !strings.Contains(output.String(), "    2  x = x + 1\n") {
+		t.Errorf("Expected :history to list entry 2, got:\n%s", output.String())
+	}
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	if last := lines[len(lines)-1]; last != "3" {
+		t.Errorf("Expected !2 to increment x again to 3, got %q", last)
+	}
+}
+
+func TestREPLHistoryMissingEntry(t *testing.T) {
+	output := &bytes.Buffer{}
+	repl := NewREPLWithIO(strings.NewReader(""), output)
+
+	if !repl.handleSpecialCommands("!5") {
+		t.Fatal("!5 should be handled as a command")
+	}
+	if got := strings.TrimSpace(output.String()); got != "No history entry 5" {
+		t.Errorf("Expected a missing entry message, got %q", got)
+	}
+	if repl.handleSpecialCommands("!x") {
+		t.Error("!x is an expression, not a command")
+	}
+}
+
+func TestREPLHistoryFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "history")
+
+	first := NewREPLWithIO(strings.NewReader("f(x_) :=\n  x + 1\n1 + 1\n"), &bytes.Buffer{})
+	if err := first.SetHistoryFile(file); err != nil {
+		t.Fatalf("SetHistoryFile error: %v", err)
+	}
+	if err := first.Run(); err != nil {
+		t.Fatalf("REPL error: %v", err)
+	}
+
+	// a new session starts with the saved entries
+	output := &bytes.Buffer{}
+	second := NewREPLWithIO(strings.NewReader("!1\nf(2)\n"), output)
+	if err := second.SetHistoryFile(file); err != nil {
+		t.Fatalf("SetHistoryFile error: %v", err)
+	}
+	if len(second.history.entries) != 2 || second.history.entries[0] != "f(x_) :=\nx + 1" {
+		t.Fatalf("Expected the saved multi-line entry, got %q", second.history.entries)
+	}
+	if err := second.Run(); err != nil {
+		t.Fatalf("REPL error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	if last := lines[len(lines)-1]; last != "3" {
+		t.Errorf("Expected the rerun definition to give f(2) = 3, got %q", last)
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("ReadFile error: %v", err)
+	}
+	if n := strings.Count(string(data), "\n"); n != 4 {
+		t.Errorf("Expected 4 saved entries, got %d:\n%s", n, data)
+	}
+}
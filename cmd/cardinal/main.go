@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	//	"github.com/client9/cardinal"
 )
 
@@ -13,16 +14,15 @@ func main() {
 		prompt = flag.String("prompt", "cardinal> ", "REPL prompt string")
 		help   = flag.Bool("help", false, "Show help message")
 		//file   = flag.String("file", "", "Execute expressions from file instead of interactive mode")
-		cmd = flag.String("c", "", "Execute expression from command line")
+		cmd  = flag.String("c", "", "Execute expression from command line")
+		safe = flag.Bool("safe", false, "Disable access to the environment and operating system")
+		exec = flag.Bool("allow-exec", false, "Allow RunProcess to run external commands (dangerous)")
+		hist = flag.String("history", defaultHistoryFile(), "File to save the REPL history in, empty for none")
 		//withUint64 = flag.Bool("with-uint64", false, "Enable experimental Uint64 type system")
 	)
 
 	flag.Parse()
-	args := flag.Args()
-	file := ""
-	if len(args) > 0 {
-		file = args[0]
-	}
+	files := flag.Args()
 
 	// Show help if requested
 	if *help {
@@ -33,6 +33,9 @@ func main() {
 	// Create REPL instance
 	repl := NewREPL()
 	repl.SetPrompt(*prompt)
+	repl.SetSafeMode(*safe)
+	repl.SetAllowExec(*exec)
+	repl.SetCommandLine(os.Args)
 	/*
 		// Enable Uint64 extension if requested
 		if *withUint64 {
@@ -52,38 +55,61 @@ func main() {
 		return
 	}
 
-	// If file is specified, execute it
-	if file != "" {
-		if err := repl.ExecuteFile(file); err != nil {
-			fmt.Fprintf(os.Stderr, "Error executing file: %v\n", err)
-			os.Exit(1)
+	// If files are specified, execute them in order in one session
+	if len(files) > 0 {
+		for _, file := range files {
+			if err := repl.ExecuteFile(file); err != nil {
+				fmt.Fprintf(os.Stderr, "Error executing %s: %v\n", file, err)
+				os.Exit(1)
+			}
 		}
 		return
 	}
 
 	// Start interactive REPL
+	if *hist != "" {
+		if err := repl.SetHistoryFile(*hist); err != nil {
+			fmt.Fprintf(os.Stderr, "Unable to load history: %v\n", err)
+		}
+	}
 	if err := repl.Run(); err != nil {
 		fmt.Fprintf(os.Stderr, "REPL error: %v\n", err)
 		os.Exit(1)
 	}
 }
 
+// defaultHistoryFile is ~/.cardinal_history, or none if there is no
+// home directory
+func defaultHistoryFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".cardinal_history")
+}
+
 // showHelp displays help information
 func showHelp() {
 	fmt.Println(`S-Expression REPL - Symbolic computation system
 
 Usage:
-  repl [flags] [file]
+  repl [flags] [file ...]
 
 Flags:
   -prompt string    Set the REPL prompt (default "cardinal> ")
   -c expression     Evaluate expression and exit
+  -safe             Disable access to the environment and operating system
+  -allow-exec       Allow RunProcess to run external commands. Scripts can
+                    then do anything you can, so only use with trusted input
+  -history file     Save the REPL history in file (default ~/.cardinal_history),
+                    or empty for none
   -help             Show this help message
 
 Examples:
   repl                               # Start interactive REPL
   repl -c 'InputForm(List(1,2,3))'   # Prints [1,2,3]
   repl examples.cardinal                # Execute file and exit
+  repl lib.cardinal main.cardinal       # Execute files in order and exit
 
 For detailed usage information, start the REPL and type 'help'.`)
 }
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+
+	"github.com/client9/cardinal/core"
 )
 
 func TestREPLMultiline(t *testing.T) {
@@ -17,13 +19,13 @@ func TestREPLMultiline(t *testing.T) {
 			name: "Simple multiline without comment",
 			input: `List(1,2,
 3)`,
-			expected: "List(1, 2, 3)",
+			expected: "[1, 2, 3]",
 		},
 		{
 			name: "Multiline with comment",
 			input: `List(1,2 # wait
 )`,
-			expected: "List(1, 2)",
+			expected: "[1, 2]",
 		},
 		{
 			name: "Complex multiline with comments",
@@ -82,39 +84,49 @@ Plus(3, 4))`,
 
 func TestREPLIncompleteExpressionDetection(t *testing.T) {
 	tests := []struct {
-		name        string
-		errorString string
-		expected    bool
+		name     string
+		input    string
+		expected bool
 	}{
 		{
-			name:        "Missing closing parenthesis",
-			errorString: "unexpected EOF, expected ')'",
-			expected:    true,
+			name:     "Missing closing parenthesis",
+			input:    "f(1, 2",
+			expected: true,
+		},
+		{
+			name:     "Missing closing bracket",
+			input:    "[1, 2",
+			expected: true,
+		},
+		{
+			name:     "Missing closing brace",
+			input:    "{a: 1",
+			expected: true,
 		},
 		{
-			name:        "Missing closing bracket",
-			errorString: "unexpected EOF, expected ']'",
-			expected:    true,
+			name:     "Missing closing grouping parenthesis",
+			input:    "(1 + 2",
+			expected: true,
 		},
 		{
-			name:        "Missing closing brace",
-			errorString: "unexpected EOF, expected '}'",
-			expected:    true,
+			name:     "Trailing binary operator",
+			input:    "1 +",
+			expected: true,
 		},
 		{
-			name:        "General unexpected EOF",
-			errorString: "unexpected EOF",
-			expected:    true,
+			name:     "Trailing delayed assignment",
+			input:    "f(x_) :=",
+			expected: true,
 		},
 		{
-			name:        "Invalid symbol error",
-			errorString: "undefined symbol: badSymbol",
-			expected:    false,
+			name:     "Unexpected token",
+			input:    "1 + * 2",
+			expected: false,
 		},
 		{
-			name:        "Type error",
-			errorString: "type mismatch in Plus",
-			expected:    false,
+			name:     "Error before a trailing operator",
+			input:    "1 + * 2 +",
+			expected: false,
 		},
 	}
 
@@ -122,9 +134,13 @@ func TestREPLIncompleteExpressionDetection(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			result := repl.isIncompleteExpression(test.errorString)
+			_, err := repl.evaluator.ParseString(test.input)
+			if err == nil {
+				t.Fatalf("Expected a parse error for %q", test.input)
+			}
+			result := repl.isIncompleteExpression(err)
 			if result != test.expected {
-				t.Errorf("Expected %v for error %q, got %v", test.expected, test.errorString, result)
+				t.Errorf("Expected %v for %q (%v), got %v", test.expected, test.input, err, result)
 			}
 		})
 	}
@@ -145,7 +161,7 @@ func TestREPLExecuteString(t *testing.T) {
 			name: "Multiline with comment",
 			input: `List(1,2 #wait
 )`,
-			expected: "List(1, 2)",
+			expected: "[1, 2]",
 		},
 		{
 			name: "Multiple expressions, returns last",
@@ -161,6 +177,34 @@ y`,
 3)      # third`,
 			expected: "6",
 		},
+		{
+			name: "Function definition continued after :=",
+			input: `f(x_) :=
+  x + 1
+f(2)`,
+			expected: "3",
+		},
+		{
+			name: "Last result",
+			input: `2 + 3
+%*2`,
+			expected: "10",
+		},
+		{
+			name: "Earlier results",
+			input: `1
+2
+3
+[%, %%, %1]`,
+			expected: "[3, 2, 1]",
+		},
+		{
+			name: "Trailing operator",
+			input: `1 +
+2 *
+3`,
+			expected: "7",
+		},
 	}
 
 	for _, test := range tests {
@@ -180,3 +224,33 @@ y`,
 		})
 	}
 }
+
+func TestREPLExecuteStringParseError(t *testing.T) {
+	output := &bytes.Buffer{}
+	repl := NewREPLWithIO(nil, output)
+
+	// the error is reported at once, not after reading the rest
+	err := repl.ExecuteString("1 + 1\n1 + * 2\n3")
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("Expected a parse error at line 2, got %v", err)
+	}
+}
+
+func TestREPLRunMultilineDefinition(t *testing.T) {
+	input := strings.NewReader("f(x_) :=\n  If(x > 0,\n    x,\n    -x)\nf(-3)\n")
+	output := &bytes.Buffer{}
+
+	repl := NewREPLWithIO(input, output)
+	if err := repl.Run(); err != nil {
+		t.Fatalf("REPL error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	if len(lines) != 2 || lines[1] != "3" {
+		t.Errorf("Expected the definition and f(-3) = 3, got:\n%s", output.String())
+	}
+	defs := repl.ctx.GetFunctionDefinitions(core.NewSymbol("f"))
+	if len(defs) != 1 {
+		t.Errorf("Expected one definition of f, got %d", len(defs))
+	}
+}
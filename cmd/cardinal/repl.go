@@ -7,6 +7,7 @@ import (
 	"io"
 	"log"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -24,6 +25,14 @@ type REPL struct {
 	input     io.Reader
 	output    io.Writer
 	prompt    string
+
+	safeMode    bool
+	allowExec   bool
+	commandLine []string
+
+	history history
+	form    string // how results are shown, "output" or "input", see :form
+	timing  bool   // show how long each evaluation took, see :timing
 }
 
 // NewREPL creates a new REPL instance
@@ -40,6 +49,7 @@ func NewREPL() *REPL {
 		input:     os.Stdin,
 		output:    os.Stdout,
 		prompt:    "cardinal> ",
+		form:      "output",
 	}
 }
 
@@ -56,9 +66,43 @@ func NewREPLWithIO(input io.Reader, output io.Writer) *REPL {
 		input:     input,
 		output:    output,
 		prompt:    "cardinal> ",
+		form:      "output",
 	}
 }
 
+// SetSafeMode turns the evaluator's safe mode on or off
+func (r *REPL) SetSafeMode(on bool) {
+	r.safeMode = on
+	r.setupEvaluator()
+}
+
+// SetAllowExec allows RunProcess to run external commands
+func (r *REPL) SetAllowExec(on bool) {
+	r.allowExec = on
+	r.setupEvaluator()
+}
+
+// SetCommandLine sets the arguments available as $CommandLine
+func (r *REPL) SetCommandLine(args []string) {
+	r.commandLine = args
+	r.setupEvaluator()
+}
+
+// setupEvaluator applies the REPL settings to a new or existing evaluator
+func (r *REPL) setupEvaluator() {
+	r.evaluator.SetSafeMode(r.safeMode)
+	r.evaluator.SetAllowExec(r.allowExec)
+	if r.commandLine != nil {
+		r.evaluator.SetCommandLine(r.commandLine)
+	}
+}
+
+// SetHistoryFile loads the history saved in file, and saves new
+// entries to it
+func (r *REPL) SetHistoryFile(file string) error {
+	return r.history.load(file)
+}
+
 // SetPrompt sets the REPL prompt
 func (r *REPL) SetPrompt(prompt string) {
 	r.prompt = prompt
@@ -75,6 +119,10 @@ func (r *REPL) isInteractive() bool {
 
 func (r *REPL) RunInteractive() error {
 	rl := readline.NewInstance()
+	// the arrow keys recall earlier sessions too
+	for _, entry := range r.history.entries {
+		_, _ = rl.History.Write(entry)
+	}
 
 	var currentExpr strings.Builder
 	var emptyLineCount int
@@ -234,11 +282,10 @@ func (r *REPL) Run() error {
 // Returns true if successful, false if the expression is incomplete
 func (r *REPL) tryProcessExpression(expr string) bool {
 	// Try to parse the expression
-	_, err := cardinal.ParseString(expr)
+	_, err := r.evaluator.ParseString(expr)
 	if err != nil {
-		errStr := err.Error()
-		// Check if this looks like an incomplete expression
-		if r.isIncompleteExpression(errStr) {
+		// Check if this is an incomplete expression
+		if r.isIncompleteExpression(err) {
 			return false
 		}
 
@@ -256,29 +303,37 @@ func (r *REPL) tryProcessExpression(expr string) bool {
 	return true
 }
 
-// isIncompleteExpression tries to determine if a parse error indicates
-// an incomplete expression (should wait for more input) vs a real error
-func (r *REPL) isIncompleteExpression(errStr string) bool {
-	// Common patterns that indicate incomplete expressions
-	incompletePatterns := []string{
-		"unexpected EOF, expected ')'",
-		"unexpected EOF, expected ']'",
-		"unexpected EOF, expected '}'",
-		"unexpected EOF, expected ','",
-		"unexpected EOF",
-	}
-
-	for _, pattern := range incompletePatterns {
-		if strings.Contains(errStr, pattern) {
-			return true
-		}
-	}
-
-	return false
+// isIncompleteExpression reports whether a parse error is because the
+// input ended too soon, such as an unclosed bracket or a trailing
+// operator, so more lines should be read
+func (r *REPL) isIncompleteExpression(err error) bool {
+	return core.IsIncomplete(err)
 }
 
 // handleSpecialCommands handles special REPL commands
 func (r *REPL) handleSpecialCommands(line string) bool {
+	// !n runs history entry n again
+	if n, err := strconv.Atoi(strings.TrimPrefix(line, "!")); err == nil && line[0] == '!' {
+		r.rerunHistory(n)
+		return true
+	}
+	if fields := strings.Fields(line); len(fields) > 0 {
+		switch fields[0] {
+		case ":form":
+			r.setForm(fields[1:])
+			return true
+		case ":timing":
+			r.setTiming(fields[1:])
+			return true
+		case ":load":
+			if len(fields) == 1 {
+				_, _ = fmt.Fprintf(r.output, "Usage: :load path\n")
+			} else if err := r.LoadFile(strings.TrimSpace(strings.TrimPrefix(line, ":load"))); err != nil {
+				_, _ = fmt.Fprintf(r.output, "Error: %v\n", err)
+			}
+			return true
+		}
+	}
 	switch line {
 	case "quit", "exit":
 		if r.isInteractive() {
@@ -292,35 +347,116 @@ func (r *REPL) handleSpecialCommands(line string) bool {
 	case "clear":
 		r.clearContext()
 		return true
+	case ":history":
+		r.printHistory()
+		return true
 	default:
 		return false
 	}
 }
 
+// setForm handles :form, which shows or changes how results are printed
+func (r *REPL) setForm(args []string) {
+	switch {
+	case len(args) == 0:
+		_, _ = fmt.Fprintf(r.output, "Results are shown in %s form\n", r.form)
+	case len(args) == 1 && (args[0] == "input" || args[0] == "output"):
+		r.form = args[0]
+	default:
+		_, _ = fmt.Fprintf(r.output, "Usage: :form input|output\n")
+	}
+}
+
+// setTiming handles :timing, which shows or changes whether the time
+// each evaluation took is printed after its result
+func (r *REPL) setTiming(args []string) {
+	switch {
+	case len(args) == 0:
+		state := "off"
+		if r.timing {
+			state = "on"
+		}
+		_, _ = fmt.Fprintf(r.output, "Timing is %s\n", state)
+	case len(args) == 1 && (args[0] == "on" || args[0] == "off"):
+		r.timing = args[0] == "on"
+	default:
+		_, _ = fmt.Fprintf(r.output, "Usage: :timing on|off\n")
+	}
+}
+
+// format returns a result as text in the current form: OutputForm by
+// default, or InputForm that can be pasted back in
+func (r *REPL) format(result core.Expr) string {
+	if r.form == "input" {
+		return result.InputForm()
+	}
+	return core.OutputForm(result)
+}
+
+// printHistory lists the expressions entered so far, numbered for !n
+func (r *REPL) printHistory() {
+	for i, entry := range r.history.entries {
+		_, _ = fmt.Fprintf(r.output, "%5d  %s\n", i+1, entry)
+	}
+}
+
+// rerunHistory shows and evaluates history entry n again
+func (r *REPL) rerunHistory(n int) {
+	entry, ok := r.history.get(n)
+	if !ok {
+		_, _ = fmt.Fprintf(r.output, "No history entry %d\n", n)
+		return
+	}
+	_, _ = fmt.Fprintf(r.output, "%s\n", entry)
+	if err := r.processLine(entry); err != nil {
+		_, _ = fmt.Fprintf(r.output, "Error: %v\n", err)
+	}
+}
+
 // processLine parses and evaluates a single line of input
 func (r *REPL) processLine(line string) error {
 	// Parse the expression
-	expr, err := cardinal.ParseString(line)
+	expr, err := r.evaluator.ParseString(line)
 	if err != nil {
 		return fmt.Errorf("parse error: %v", err)
 	}
+	if err := r.history.add(line); err != nil {
+		log.Printf("Unable to save history: %v", err)
+	}
 
 	// Evaluate the expression
+	start := time.Now()
 	result := r.evaluator.Evaluate(expr)
+	elapsed := time.Since(start)
+
+	r.printMessages()
 
 	if errVal, ok := core.AsError(result); ok {
 		st := errVal.StackTrace()
 		for _, frame := range st {
 			_, _ = fmt.Fprintf(r.output, "%s: %s\n", frame.ErrorType, frame.Arg)
 		}
-		return nil
+	} else {
+		r.ctx.AddOutput(result)
+		// Print the result
+		_, _ = fmt.Fprintf(r.output, "%s\n", r.format(result))
 	}
-	// Print the result
-	_, _ = fmt.Fprintf(r.output, "%s\n", result.String())
 
+	if r.timing {
+		_, _ = fmt.Fprintf(r.output, "Time: %g seconds\n", elapsed.Seconds())
+	}
 	return nil
 }
 
+// printMessages shows the diagnostics recorded by Message during the
+// last evaluation, and clears them
+func (r *REPL) printMessages() {
+	for _, msg := range r.ctx.Messages() {
+		_, _ = fmt.Fprintf(r.output, "%s\n", msg)
+	}
+	r.ctx.ClearMessages()
+}
+
 // printHelp prints help information
 func (r *REPL) printHelp() {
 	_, _ = fmt.Fprintf(r.output, `
@@ -333,6 +469,13 @@ Commands:
   clear          - Clear all variable assignments
   attributes     - Show all symbols with their attributes
   :reset, :clear - Abandon current multi-line expression
+  :history       - List the expressions entered so far
+  !n             - Evaluate history entry n again
+  %%, %%%%, %%n      - The last result, the one before, and result n
+  :form input    - Show results as input syntax
+  :form output   - Show results for reading, the default
+  :timing on|off - Show how long each evaluation takes
+  :load path     - Evaluate the expressions in a file
   
 Multi-line input:
   - Incomplete expressions (missing ) ] }) continue on next line
@@ -367,6 +510,7 @@ func (r *REPL) clearContext() {
 	r.evaluator = cardinal.NewEvaluator()
 	r.ctx = r.evaluator.GetContext()
 	cardinal.SetupBuiltinAttributes(r.ctx.GetSymbolTable())
+	r.setupEvaluator()
 }
 
 // exprInfo represents a parsed expression with its location information
@@ -406,7 +550,7 @@ func (r *REPL) parseFileContent(content string) ([]exprInfo, error) {
 		currentExpr.WriteString(line)
 
 		// Try to parse the current accumulated expression
-		_, err := cardinal.ParseString(currentExpr.String())
+		_, err := r.evaluator.ParseString(currentExpr.String())
 		if err == nil {
 			// Successfully parsed - we have a complete expression
 			expressions = append(expressions, exprInfo{
@@ -414,17 +558,18 @@ func (r *REPL) parseFileContent(content string) ([]exprInfo, error) {
 				startLine: startLine,
 			})
 			currentExpr.Reset()
-		} else {
-			// Parse failed - this might be a multi-line expression
-			// Continue accumulating lines until we get a complete expression
-			// or reach end of file
+		} else if !r.isIncompleteExpression(err) {
+			// More lines can't fix this
+			return nil, fmt.Errorf("parse error in expression starting at line %d: %v", startLine, err)
 		}
+		// Otherwise this is a multi-line expression, continue
+		// accumulating lines until it is complete
 	}
 
 	// Check if we have an incomplete expression at the end
 	if currentExpr.Len() > 0 {
 		// Try to parse one more time
-		_, err := cardinal.ParseString(currentExpr.String())
+		_, err := r.evaluator.ParseString(currentExpr.String())
 		if err != nil {
 			return nil, fmt.Errorf("incomplete expression starting at line %d: %v", startLine, err)
 		}
@@ -439,7 +584,7 @@ func (r *REPL) parseFileContent(content string) ([]exprInfo, error) {
 
 // EvaluateString is a convenience function for evaluating a string expression
 func (r *REPL) EvaluateString(input string) (string, error) {
-	expr, err := cardinal.ParseString(input)
+	expr, err := r.evaluator.ParseString(input)
 	if err != nil {
 		return "", fmt.Errorf("parse error: %v", err)
 	}
@@ -453,7 +598,8 @@ func (r *REPL) EvaluateString(input string) (string, error) {
 		}
 		return strings.Join(out, "\n"), fmt.Errorf("Failed")
 	}
-	return result.String(), nil
+	r.ctx.AddOutput(result)
+	return r.format(result), nil
 }
 
 // GetEvaluator returns the underlying evaluator (for testing purposes)
@@ -474,6 +620,7 @@ func (r *REPL) ExecuteString(content string) error {
 	for _, exprInfo := range expressions {
 		// Execute the expression
 		result, err := r.EvaluateString(exprInfo.text)
+		r.printMessages()
 		if err != nil {
 			return fmt.Errorf("error in expression (line %d): %v", exprInfo.startLine, err)
 		}
@@ -488,6 +635,30 @@ func (r *REPL) ExecuteString(content string) error {
 	return nil
 }
 
+// LoadFile evaluates the expressions in a file in the current session,
+// printing only their messages. An error stops the load and reports the
+// file and line of the expression that failed.
+func (r *REPL) LoadFile(filename string) error {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	expressions, err := r.parseFileContent(string(content))
+	if err != nil {
+		return fmt.Errorf("%s: %v", filename, err)
+	}
+
+	for _, exprInfo := range expressions {
+		result, err := r.EvaluateString(exprInfo.text)
+		r.printMessages()
+		if err != nil {
+			return fmt.Errorf("%s, line %d: %s", filename, exprInfo.startLine, result)
+		}
+	}
+	return nil
+}
+
 // ExecuteFile executes expressions from a file
 func (r *REPL) ExecuteFile(filename string) error {
 	// Read file content
@@ -509,6 +680,7 @@ func (r *REPL) ExecuteFile(filename string) error {
 
 		// Execute the expression
 		result, err := r.EvaluateString(exprInfo.text)
+		r.printMessages()
 		if err != nil {
 			_, _ = fmt.Fprintf(r.output, "Out(%d): %s\n", i+1, result)
 			return fmt.Errorf("error at expression %d (line %d): %v", i+1, exprInfo.startLine, err)
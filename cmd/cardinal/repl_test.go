@@ -3,6 +3,8 @@ package main
 import (
 	"bytes"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -151,6 +153,174 @@ func TestREPL_ProcessLine(t *testing.T) {
 	}
 }
 
+func TestREPL_ProcessLineMessages(t *testing.T) {
+	output := &bytes.Buffer{}
+	repl := NewREPLWithIO(strings.NewReader(""), output)
+
+	err := repl.processLine("Message(\"x is `1`\", 5); 1 + 2")
+	if err != nil {
+		t.Fatalf("processLine error: %v", err)
+	}
+
+	result := strings.TrimSpace(output.String())
+	if result != "x is 5\n3" {
+		t.Errorf("Expected message then result, got '%s'", result)
+	}
+	if len(repl.ctx.Messages()) != 0 {
+		t.Errorf("Expected messages to be cleared, got %v", repl.ctx.Messages())
+	}
+}
+
+func TestREPL_Form(t *testing.T) {
+	output := &bytes.Buffer{}
+	repl := NewREPLWithIO(strings.NewReader(""), output)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Hold((a + b) * (c - d)^2)", "Hold((a + b) * (c - d)^2)"},
+		{"Hold(a + b * c / (d + e))", "Hold(a + b * c / (d + e))"},
+		{"-x + 3", "3 - x"},
+		{"2 * (x + 1)^2", "2 * (1 + x)^2"},
+		{"x / y", "x / y"},
+		{`[1, "a", {k: 2 + z}]`, "[1, a, {k: 2 + z}]"},
+	}
+	for _, tt := range tests {
+		output.Reset()
+		if err := repl.processLine(tt.input); err != nil {
+			t.Fatalf("processLine(%q) error: %v", tt.input, err)
+		}
+		if got := strings.TrimSpace(output.String()); got != tt.expected {
+			t.Errorf("processLine(%q) printed %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+
+	if !repl.handleSpecialCommands(":form input") {
+		t.Fatal(":form should be a special command")
+	}
+	output.Reset()
+	if err := repl.processLine(`["a", HoldForm(1 + 2)]`); err != nil {
+		t.Fatalf("processLine error: %v", err)
+	}
+	if got, want := strings.TrimSpace(output.String()), `["a", HoldForm(1 + 2)]`; got != want {
+		t.Errorf("InputForm result: got %q, want %q", got, want)
+	}
+	output.Reset()
+	if err := repl.processLine(`Hold(a - (b - c), x_)`); err != nil {
+		t.Fatalf("processLine error: %v", err)
+	}
+	if got, want := strings.TrimSpace(output.String()), `Hold(a - (b - c), x_)`; got != want {
+		t.Errorf("InputForm result: got %q, want %q", got, want)
+	}
+
+	output.Reset()
+	repl.handleSpecialCommands(":form")
+	if got := output.String(); !strings.Contains(got, "input form") {
+		t.Errorf(":form should report the current form, got %q", got)
+	}
+
+	output.Reset()
+	repl.handleSpecialCommands(":form latex")
+	if got := output.String(); !strings.Contains(got, "Usage") {
+		t.Errorf(":form latex should print usage, got %q", got)
+	}
+	if repl.form != "input" {
+		t.Errorf("a bad :form should not change the form, got %q", repl.form)
+	}
+}
+
+func TestREPL_Timing(t *testing.T) {
+	output := &bytes.Buffer{}
+	repl := NewREPLWithIO(strings.NewReader(""), output)
+
+	if err := repl.processLine("1 + 2"); err != nil {
+		t.Fatalf("processLine error: %v", err)
+	}
+	if got := strings.TrimSpace(output.String()); got != "3" {
+		t.Errorf("timing is off by default, got %q", got)
+	}
+
+	if !repl.handleSpecialCommands(":timing on") {
+		t.Fatal(":timing should be a special command")
+	}
+	for _, input := range []string{"1 + 2", "Throw(1)"} {
+		output.Reset()
+		if err := repl.processLine(input); err != nil {
+			t.Fatalf("processLine(%q) error: %v", input, err)
+		}
+		lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+		last := lines[len(lines)-1]
+		if len(lines) < 2 || !strings.HasPrefix(last, "Time: ") || !strings.HasSuffix(last, " seconds") {
+			t.Errorf("processLine(%q) should end with the time, got %q", input, output.String())
+		}
+	}
+
+	output.Reset()
+	repl.handleSpecialCommands(":timing")
+	if got := strings.TrimSpace(output.String()); got != "Timing is on" {
+		t.Errorf(":timing should report the setting, got %q", got)
+	}
+
+	repl.handleSpecialCommands(":timing off")
+	output.Reset()
+	if err := repl.processLine("1 + 2"); err != nil {
+		t.Fatalf("processLine error: %v", err)
+	}
+	if got := strings.TrimSpace(output.String()); got != "3" {
+		t.Errorf("timing should be off again, got %q", got)
+	}
+
+	output.Reset()
+	repl.handleSpecialCommands(":timing maybe")
+	if got := output.String(); !strings.Contains(got, "Usage") {
+		t.Errorf(":timing maybe should print usage, got %q", got)
+	}
+}
+
+func TestREPL_Load(t *testing.T) {
+	dir := t.TempDir()
+	lib := filepath.Join(dir, "lib.cardinal")
+	content := "# squares\nsquare(x_) := x^2\n\nbase = Plus(1,\n  2)\n"
+	if err := os.WriteFile(lib, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	output := &bytes.Buffer{}
+	repl := NewREPLWithIO(strings.NewReader(""), output)
+
+	if !repl.handleSpecialCommands(":load " + lib) {
+		t.Fatal(":load should be a special command")
+	}
+	if output.Len() != 0 {
+		t.Errorf(":load should not print results, got %q", output.String())
+	}
+	if err := repl.processLine("square(base)"); err != nil {
+		t.Fatalf("processLine error: %v", err)
+	}
+	if got := strings.TrimSpace(output.String()); got != "9" {
+		t.Errorf("Expected the loaded definitions to give 9, got %q", got)
+	}
+
+	bad := filepath.Join(dir, "bad.cardinal")
+	if err := os.WriteFile(bad, []byte("a = 1\n\nb = Part([1],\n  5)\nc = 3\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	err := repl.LoadFile(bad)
+	if err == nil || !strings.HasPrefix(err.Error(), bad+", line 3: ") {
+		t.Errorf("Expected an error at %s, line 3, got %v", bad, err)
+	}
+	if result, _ := repl.EvaluateString("[a, c]"); result != "[1, c]" {
+		t.Errorf("Loading should stop at the error, got %s", result)
+	}
+
+	output.Reset()
+	repl.handleSpecialCommands(":load " + filepath.Join(dir, "missing.cardinal"))
+	if got := output.String(); !strings.HasPrefix(got, "Error: ") || !strings.Contains(got, "missing.cardinal") {
+		t.Errorf("Expected an error naming the missing file, got %q", got)
+	}
+}
+
 func TestREPL_SpecialCommands(t *testing.T) {
 	output := &bytes.Buffer{}
 	repl := NewREPLWithIO(strings.NewReader(""), output)
@@ -2,8 +2,10 @@ package core
 
 import (
 	"fmt"
-	"github.com/client9/cardinal/core/symbol"
+	"slices"
 	"strings"
+
+	"github.com/client9/cardinal/core/symbol"
 )
 
 // AssociationEntry represents a key-value pair in an association
@@ -15,31 +17,26 @@ type AssociationEntry struct {
 // Association implements Expr for association data structure
 // Uses hash buckets for efficient lookup while preserving insertion order
 type Association struct {
-	buckets map[string][]AssociationEntry // Hash buckets for lookup
+	buckets map[uint64][]AssociationEntry // Hash buckets for lookup
 	order   []Expr                        // Preserve insertion order of keys
 }
 
 // NewAssociation creates a new empty association
 func NewAssociation() Association {
 	return Association{
-		buckets: make(map[string][]AssociationEntry),
+		buckets: make(map[uint64][]AssociationEntry),
 		order:   []Expr{},
 	}
 }
 
-// hashKey computes a hash string for any expression
-func hashKey(key Expr) string {
-	return key.String()
-}
-
 // Set adds or updates a key-value pair and returns a new Association
 // This maintains immutability by creating a new association instead of modifying in place
 func (a Association) Set(key Expr, value Expr) Association {
-	hash := hashKey(key)
+	hash := Hash(key)
 
 	// Create a copy of the association
 	newAssoc := Association{
-		buckets: make(map[string][]AssociationEntry, len(a.buckets)),
+		buckets: make(map[uint64][]AssociationEntry, len(a.buckets)),
 		order:   make([]Expr, len(a.order)),
 	}
 
@@ -58,7 +55,7 @@ func (a Association) Set(key Expr, value Expr) Association {
 	// Check if key already exists in bucket
 	if bucket, exists := newAssoc.buckets[hash]; exists {
 		for i, entry := range bucket {
-			if entry.Key.Equal(key) {
+			if sameExpr(entry.Key, key) {
 				// Update existing entry in the new copy
 				bucket[i].Value = value
 				keyExists = true
@@ -84,11 +81,11 @@ func (a Association) Set(key Expr, value Expr) Association {
 
 // Get retrieves a value by key
 func (a Association) Get(key Expr) (Expr, bool) {
-	hash := hashKey(key)
+	hash := Hash(key)
 
 	if bucket, exists := a.buckets[hash]; exists {
 		for _, entry := range bucket {
-			if entry.Key.Equal(key) {
+			if sameExpr(entry.Key, key) {
 				return entry.Value, true
 			}
 		}
@@ -96,31 +93,19 @@ func (a Association) Get(key Expr) (Expr, bool) {
 	return nil, false
 }
 
+// ElementByKey implements Keyed, returning Missing("KeyAbsent", key) if
+// the key is not present
+func (a Association) ElementByKey(key Expr) Expr {
+	if value, ok := a.Get(key); ok {
+		return value
+	}
+	return ListFrom(symbol.Missing, NewString("KeyAbsent"), key)
+}
+
 // Keys returns all keys in insertion order
 func (a Association) Keys() []Expr {
-	result := make([]Expr, 0, len(a.order))
-
-	// Filter out duplicates while preserving order
-	// Note: With immutable Set operations, duplicates in order should be rare
-	seen := make(map[string]bool, len(a.order))
-	for _, key := range a.order {
-		hash := hashKey(key)
-		if !seen[hash] {
-			// Since associations are now immutable, we can trust the order
-			// Only check existence in buckets, which is more efficient than Get()
-			if bucket, exists := a.buckets[hash]; exists {
-				// Verify the key actually exists in the bucket
-				for _, entry := range bucket {
-					if entry.Key.Equal(key) {
-						result = append(result, key)
-						seen[hash] = true
-						break
-					}
-				}
-			}
-		}
-	}
-	return result
+	// Set only adds a key to order the first time it is seen
+	return slices.Clone(a.order)
 }
 
 // Values returns all values in key insertion order
@@ -138,12 +123,12 @@ func (a Association) Values() []Expr {
 
 // Length returns the number of key-value pairs
 func (a Association) Length() int64 {
-	return int64(len(a.Keys())) // Account for any duplicates in order slice
+	return int64(len(a.order))
 }
 
 // Len returns the number of key-value pairs as int
 func (a Association) Len() int {
-	return len(a.Keys())
+	return len(a.order)
 }
 
 // String implements Expr interface - used for FullForm representation
@@ -164,18 +149,7 @@ func (a Association) String() string {
 
 // InputForm implements Expr interface - used for user-friendly representation
 func (a Association) InputForm() string {
-	if a.Len() == 0 {
-		return "{}"
-	}
-
-	var parts []string
-	keys := a.Keys()
-	for _, key := range keys {
-		if value, exists := a.Get(key); exists {
-			parts = append(parts, fmt.Sprintf("%s: %s", key.InputForm(), value.InputForm()))
-		}
-	}
-	return fmt.Sprintf("{%s}", strings.Join(parts, ", "))
+	return inputForm(a, PrecedenceLowest)
 }
 
 func (a Association) Head() Expr {
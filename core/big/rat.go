@@ -220,9 +220,14 @@ func (z *Rat) SetFloat64(x float64) {
 	}
 }
 
-// TODO SETFRAC
+// SetFrac sets z to a/b and returns z.  If b == 0, SetFrac panics.
 func (z *Rat) SetFrac(a, b *Int) *Rat {
-	return nil
+	if b.Sign() == 0 {
+		panic("division by zero")
+	}
+	den := new(Rat).SetInt(b)
+	z.SetInt(a)
+	return z.Quo(z, den)
 }
 
 func (z *Rat) SetFrac64(a, b int64) *Rat {
@@ -1,32 +1,129 @@
 package core
 
-// Should be called "Less"
+import (
+	"cmp"
+
+	"github.com/client9/cardinal/core/big"
+)
 
 // CanonicalCompare provides a canonical comparison function for expressions
 // Used for consistent ordering across mathematical functions and Orderless attribute
 // Returns true if expr1 should come before expr2 in canonical ordering
 func CanonicalCompare(expr1, expr2 Expr) bool {
-	// Mathematical ordering: numbers first, then other expressions
-	_, expr1IsNumber := GetNumericValue(expr1)
-	_, expr2IsNumber := GetNumericValue(expr2)
+	return CompareExpr(expr1, expr2) < 0
+}
+
+// InCanonicalOrder reports whether sorting exprs by CompareExpr would
+// leave them unchanged
+func InCanonicalOrder(exprs []Expr) bool {
+	for i := 1; i < len(exprs); i++ {
+		if CompareExpr(exprs[i-1], exprs[i]) > 0 {
+			return false
+		}
+	}
+	return true
+}
 
-	if expr1IsNumber && !expr2IsNumber {
-		return true // Numbers come before non-numbers
+// CompareExpr is the canonical ordering of expressions, returning -1 if
+// x comes before y, 0 if they are identical and +1 otherwise.
+//
+// Numbers come first, ordered by value, followed by symbols, then strings,
+// then any other atoms, and finally compound expressions.  Symbols and
+// strings are ordered by name.  Compound expressions are ordered by length,
+// then by head, then element by element.
+func CompareExpr(x, y Expr) int {
+	if c := cmp.Compare(orderRank(x), orderRank(y)); c != 0 {
+		return c
 	}
-	if !expr1IsNumber && expr2IsNumber {
-		return false // Non-numbers come after numbers
+	switch xv := x.(type) {
+	case Number:
+		yv := y.(Number)
+		if c := CompareNumbers(xv, yv); c != 0 {
+			return c
+		}
+		// 1 before 1/1 before 1.0
+		return cmp.Compare(numberRank(xv), numberRank(yv))
+	case Symbol:
+		return cmp.Compare(xv.String(), y.(Symbol).String())
+	case String:
+		return cmp.Compare(xv, y.(String))
+	case List:
+		yv := y.(List)
+		if c := cmp.Compare(xv.Length(), yv.Length()); c != 0 {
+			return c
+		}
+		if c := CompareExpr(xv.Head(), yv.Head()); c != 0 {
+			return c
+		}
+		ys := yv.Tail()
+		for i, xe := range xv.Tail() {
+			if c := CompareExpr(xe, ys[i]); c != 0 {
+				return c
+			}
+		}
+		return 0
 	}
+	if c := CompareExpr(x.Head(), y.Head()); c != 0 {
+		return c
+	}
+	return cmp.Compare(x.String(), y.String())
+}
 
-	// Both are numbers or both are non-numbers, use standard ordering
-	// First compare by length (complexity)
-	cmp := expr1.Length() - expr2.Length()
-	if cmp < 0 {
-		return true
+func orderRank(x Expr) int {
+	switch x.(type) {
+	case Number:
+		return 0
+	case Symbol:
+		return 1
+	case String:
+		return 2
+	case List:
+		return 4
 	}
-	if cmp > 0 {
-		return false
+	return 3
+}
+
+func numberRank(x Number) int {
+	switch x.(type) {
+	case Integer:
+		return 0
+	case Rational:
+		return 1
 	}
+	return 2
+}
+
+// CompareNumbers compares two numbers by value, returning -1, 0 or +1.
+// Integers and Rationals are compared exactly; if either is a Real the
+// comparison is done in floating point.
+func CompareNumbers(x, y Number) int {
+	xi, xok := x.(Integer)
+	yi, yok := y.(Integer)
+	if xok && yok {
+		if xi.IsInt64() && yi.IsInt64() {
+			return cmp.Compare(xi.Int64(), yi.Int64())
+		}
+		return xi.AsBigInt().Cmp(yi.AsBigInt())
+	}
+
+	xr, xok := ExactRat(x)
+	yr, yok := ExactRat(y)
+	if xok && yok {
+		return xr.Cmp(yr)
+	}
+
+	xf := ToBigFloat(new(big.Float), x)
+	yf := ToBigFloat(new(big.Float), y)
+	return xf.Cmp(yf)
+}
 
-	// If lengths are equal, compare by string representation for deterministic ordering
-	return expr1.String() < expr2.String()
+// ExactRat converts an Integer or Rational to a big.Rat.  It fails for Reals.
+func ExactRat(x Number) (*big.Rat, bool) {
+	switch n := x.(type) {
+	case Integer:
+		return new(big.Rat).SetInt(n.AsBigInt()), true
+	case Rational:
+		return n.AsBigRat(), true
+	}
+	return nil, false
 }
@@ -36,6 +36,36 @@ func TestCompareExpr(t *testing.T) {
 			b:    NewString("aa"),
 			less: false,
 		},
+		{
+			name: "numbers by value",
+			a:    NewInteger(9),
+			b:    NewInteger(10),
+			less: true,
+		},
+		{
+			name: "number before symbol",
+			a:    NewInteger(10),
+			b:    NewSymbol("a"),
+			less: true,
+		},
+		{
+			name: "symbol before string",
+			a:    NewSymbol("b"),
+			b:    NewString("a"),
+			less: true,
+		},
+		{
+			name: "string before list",
+			a:    NewString("zz"),
+			b:    NewList(NewSymbol("f"), NewSymbol("x")),
+			less: true,
+		},
+		{
+			name: "lists by element",
+			a:    NewList(NewSymbol("f"), NewInteger(9)),
+			b:    NewList(NewSymbol("f"), NewInteger(10)),
+			less: true,
+		},
 	}
 
 	for _, tt := range cases {
@@ -46,3 +76,24 @@ func TestCompareExpr(t *testing.T) {
 		}
 	}
 }
+
+func TestInCanonicalOrder(t *testing.T) {
+	cases := []struct {
+		input   string
+		ordered bool
+	}{
+		{"[]", true},
+		{"[x]", true},
+		{"[1, 1.0, 2, x, \"a\", f(x)]", true},
+		{"[a, a, b]", true},
+		{"[b, a]", false},
+		{"[f(x), x]", false},
+		{"[1.0, 1]", false},
+	}
+	for _, tt := range cases {
+		list := MustParse(tt.input).(List)
+		if got := InCanonicalOrder(list.Tail()); got != tt.ordered {
+			t.Errorf("InCanonicalOrder(%s) = %v, want %v", tt.input, got, tt.ordered)
+		}
+	}
+}
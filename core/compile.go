@@ -167,6 +167,8 @@ func (c *Compile) Simple(e Expr) bool {
 		return c.Simple(args[0])
 	case symbol.MatchHead, symbol.MatchAny, symbol.Blank:
 		return true
+	case symbol.Repeated, symbol.RepeatedNull:
+		return c.Simple(list.Tail()[0])
 	case symbol.PatternSequence, symbol.List:
 		return c.SimpleList(list.Tail())
 	}
@@ -240,6 +242,9 @@ func (c *Compile) isZeroPattern(e Expr) bool {
 		case symbol.BlankNullSequence, symbol.Optional:
 			return true
 
+		case symbol.Repeated, symbol.RepeatedNull:
+			_, lo, _ := IsRepeated(e)
+			return lo == 0
 		}
 	}
 	return false
@@ -253,7 +258,8 @@ func (c *Compile) isSequencePattern(e Expr) bool {
 			return true
 
 		// MMA compatible
-		case symbol.BlankNullSequence, symbol.BlankSequence, symbol.Optional:
+		case symbol.BlankNullSequence, symbol.BlankSequence, symbol.Optional,
+			symbol.Repeated, symbol.RepeatedNull:
 			return true
 
 		case symbol.Pattern, symbol.PatternSequence, symbol.List:
@@ -330,7 +336,8 @@ func (c *Compile) IsListLiteral(list List) bool {
 				return false
 
 			// mma primitives
-			case symbol.Blank, symbol.BlankSequence, symbol.BlankNullSequence, symbol.Optional:
+			case symbol.Blank, symbol.BlankSequence, symbol.BlankNullSequence, symbol.Optional,
+				symbol.Repeated, symbol.RepeatedNull:
 				return false
 
 			// low level primitives
@@ -424,6 +431,8 @@ func (c *Compile) emitOneStep(e Expr) {
 			arg = ListFrom(symbol.MatchHead, arg)
 		}
 		c.emitOneStep(ListFrom(symbol.MatchQuest, arg))
+	case symbol.Repeated, symbol.RepeatedNull:
+		c.emitOneStep(expandRepeated(e))
 
 	case symbol.Pattern:
 		// Pattern("x", expression)
@@ -598,6 +607,8 @@ func (c *Compile) emit(e Expr) {
 			arg = ListFrom(symbol.MatchHead, list.Tail()[0])
 		}
 		c.emit(ListFrom(symbol.MatchQuest, arg))
+	case symbol.Repeated, symbol.RepeatedNull:
+		c.emit(expandRepeated(e))
 
 	case symbol.Pattern:
 		// Pattern("x", expression)
@@ -700,6 +711,39 @@ func (c *Compile) emit(e Expr) {
 	}
 }
 
+// expandRepeated rewrites a bounded Repeated into primitives by unrolling
+// the required copies, followed by optional copies:
+//
+//	Repeated(p)                --> MatchPlus(p)
+//	RepeatedNull(p)            --> MatchStar(p)
+//	Repeated(p, [2, 4])        --> PatternSequence(p, p, MatchQuest(p), MatchQuest(p))
+//	Repeated(p, [2, Infinity]) --> PatternSequence(p, p, MatchStar(p))
+func expandRepeated(e Expr) Expr {
+	p, lo, hi := IsRepeated(e)
+	if p == nil {
+		panic("Invalid Repeated pattern")
+	}
+	switch {
+	case lo == 0 && hi == -1:
+		return ListFrom(symbol.MatchStar, p)
+	case lo == 1 && hi == -1:
+		return ListFrom(symbol.MatchPlus, p)
+	}
+
+	args := make([]Expr, 0, max(lo, hi))
+	for range lo {
+		args = append(args, p)
+	}
+	if hi == -1 {
+		args = append(args, ListFrom(symbol.MatchStar, p))
+	} else {
+		for range hi - lo {
+			args = append(args, ListFrom(symbol.MatchQuest, p))
+		}
+	}
+	return ListFrom(symbol.PatternSequence, args...)
+}
+
 type InstOp uint8
 
 const (
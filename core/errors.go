@@ -13,6 +13,10 @@ type ErrorExpr struct {
 	Message   string // Detailed error message
 	Arg       Expr
 	Err       *ErrorExpr
+
+	// Value and Tag are set by Throw, see NewThrow
+	Value Expr
+	Tag   Expr
 }
 
 func NewError(etype string, message string) ErrorExpr {
@@ -22,6 +26,27 @@ func NewError(etype string, message string) ErrorExpr {
 	}
 }
 
+// NewThrow returns the error used by Throw(value, tag) to unwind the
+// evaluation up to the enclosing Catch.  Tag is nil for Throw(value).
+func NewThrow(value Expr, tag Expr) ErrorExpr {
+	return ErrorExpr{
+		ErrorType: "Throw",
+		Message:   "uncaught Throw",
+		Value:     value,
+		Tag:       tag,
+	}
+}
+
+// AsThrow returns the thrown value and tag if the error originated
+// from a Throw.
+func (e ErrorExpr) AsThrow() (value Expr, tag Expr, ok bool) {
+	origin := e.StackTrace()[0]
+	if origin.ErrorType != "Throw" || origin.Value == nil {
+		return nil, nil, false
+	}
+	return origin.Value, origin.Tag, true
+}
+
 func (e ErrorExpr) SetCaller(arg Expr) ErrorExpr {
 	e.Arg = arg
 	return e
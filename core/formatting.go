@@ -1,200 +1,135 @@
 package core
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/client9/cardinal/core/symbol"
 )
 
-// inputFormWithPrecedence formats a List with precedence-aware operator handling
-func (l List) inputFormWithPrecedence(parentPrecedence Precedence) string {
-	if l.Length() == 0 {
-		return "List()"
+// inputForm prints e so that the parser reads back the same expression.
+// It uses the operators and precedence of OutputForm, but keeps every
+// head as it is: strings are quoted, a - b is only Subtract(a, b) and
+// Pattern(x, Blank()) is x_.
+func inputForm(e Expr, parent Precedence) string {
+	switch ex := e.(type) {
+	case Number:
+		return outputForm(ex, parent)
+	case Association:
+		var parts []string
+		for _, key := range ex.Keys() {
+			value, _ := ex.Get(key)
+			parts = append(parts, inputForm(key, PrecedenceRule+1)+": "+inputForm(value, PrecedenceRule))
+		}
+		return "{" + strings.Join(parts, ", ") + "}"
+	case FunctionExpr:
+		var params []string
+		for _, p := range ex.Parameters {
+			params = append(params, p.InputForm())
+		}
+		if len(params) > 1 {
+			return "Function([" + strings.Join(params, ", ") + "], " + inputForm(ex.Body, PrecedenceLowest) + ")"
+		}
+		return "Function(" + strings.Join(append(params, inputForm(ex.Body, PrecedenceLowest)), ", ") + ")"
+	case List:
+		return ex.inputForm(parent)
 	}
+	return e.InputForm()
+}
 
-	// Check if this is a special function that has infix/shortcut representation
-	switch l.Head() {
+// inputForm prints a compound expression, see inputForm
+func (l List) inputForm(parent Precedence) string {
+	if len(l.elements) == 0 {
+		return "List()"
+	}
+	args := l.Tail()
+	head := l.Head()
+	switch head {
 	case symbol.List:
-		// List(...) -> [...]
-		if l.Length() == 0 {
-			return "[]"
-		}
-		var elements []string
-		for _, elem := range l.Tail() {
-			elements = append(elements, elem.InputForm())
-		}
-		return fmt.Sprintf("[%s]", strings.Join(elements, ", "))
-
+		return "[" + inputArguments(args) + "]"
 	case symbol.Association:
-		// Association(Rule(a,b), Rule(c,d)) -> {a: b, c: d}
-		if l.Length() == 1 {
-			return "{}"
-		}
-		var pairs []string
-		for _, elem := range l.Tail() {
-			if ruleList, ok := elem.(List); ok && ruleList.Length() == 2 && ruleList.Head() == symbol.Rule {
-				args := ruleList.Tail()
-				key := args[0].InputForm()
-				value := args[1].InputForm()
-				pairs = append(pairs, fmt.Sprintf("%s: %s", key, value))
-				continue
+		// a held {k: v} is still a list of rules
+		if inputRules(args) {
+			parts := make([]string, len(args))
+			for i, rule := range args {
+				kv := rule.(List).Tail()
+				parts[i] = inputForm(kv[0], PrecedenceRule+1) + ": " + inputForm(kv[1], PrecedenceRule)
 			}
-			// Fallback for non-Rule elements
-			pairs = append(pairs, elem.InputForm())
-		}
-		return fmt.Sprintf("{%s}", strings.Join(pairs, ", "))
-
-	case symbol.Rule:
-		// Rule(a, b) -> a: b
-		if l.Length() == 2 {
-			e := l.Tail()
-			return fmt.Sprintf("%s: %s", e[0].InputForm(), e[1].InputForm())
-		}
-
-	case symbol.RuleDelayed:
-		// RuleDelayed(a, b) -> a => b
-		if l.Length() == 2 {
-			e := l.Tail()
-			return fmt.Sprintf("%s => %s", e[0].InputForm(), e[1].InputForm())
+			return "{" + strings.Join(parts, ", ") + "}"
 		}
-
-	case symbol.Set:
-		// Set(a, b) -> a = b
-		if l.Length() == 2 {
-			return l.formatInfixWithParens("=", PrecedenceAssign, parentPrecedence)
-		}
-
-	case symbol.SetDelayed:
-		// SetDelayed(a, b) -> a := b
-		if l.Length() == 2 {
-			return l.formatInfixWithParens(":=", PrecedenceAssign, parentPrecedence)
-		}
-
 	case symbol.Plus:
-		// Plus(a, b, ...) -> a + b + ...
-		if l.Length() > 1 {
-			return l.formatLeftAssociativeInfix("+", PrecedenceSum, parentPrecedence)
+		if len(args) > 1 {
+			return outputInfix(args, outputOperator{op: " + ", precedence: PrecedenceSum, nary: true}, parent, inputForm)
 		}
-
 	case symbol.Times:
-		// Times(a, b, ...) -> a * b * ...
-		if l.Length() > 1 {
-			return l.formatLeftAssociativeInfix("*", PrecedenceProduct, parentPrecedence)
-		}
-
-	case symbol.Subtract:
-		// Subtract(a, b) -> a - b
-		if l.Length() == 2 {
-			return l.formatInfixWithParens("-", PrecedenceSum, parentPrecedence)
-		}
-
-	case symbol.Divide:
-		// Divide(a, b) -> a / b
-		if l.Length() == 2 {
-			return l.formatInfixWithParens("/", PrecedenceProduct, parentPrecedence)
-		}
-
-	case symbol.Equal:
-		// Equal(a, b) -> a == b
-		if l.Length() == 2 {
-			return l.formatInfixWithParens("==", PrecedenceEquality, parentPrecedence)
-		}
-
-	case symbol.Unequal:
-		// Unequal(a, b) -> a != b
-		if l.Length() == 2 {
-			return l.formatInfixWithParens("!=", PrecedenceEquality, parentPrecedence)
-		}
-
-	case symbol.SameQ:
-		// SameQ(a, b) -> a === b
-		if l.Length() == 2 {
-			return l.formatInfixWithParens("===", PrecedenceEquality, parentPrecedence)
-		}
-
-	case symbol.UnsameQ:
-		// UnsameQ(a, b) -> a =!= b
-		if l.Length() == 2 {
-			return l.formatInfixWithParens("=!=", PrecedenceEquality, parentPrecedence)
-		}
-
-	case symbol.Less:
-		// Less(a, b) -> a < b
-		if l.Length() == 2 {
-			return l.formatInfixWithParens("<", PrecedenceComparison, parentPrecedence)
-		}
-
-	case symbol.Greater:
-		// Greater(a, b) -> a > b
-		if l.Length() == 2 {
-			return l.formatInfixWithParens(">", PrecedenceComparison, parentPrecedence)
-		}
-
-	case symbol.LessEqual:
-		// LessEqual(a, b) -> a <= b
-		if l.Length() == 2 {
-			return l.formatInfixWithParens("<=", PrecedenceComparison, parentPrecedence)
-		}
-
-	case symbol.GreaterEqual:
-		// GreaterEqual(a, b) -> a >= b
-		if l.Length() == 2 {
-			return l.formatInfixWithParens(">=", PrecedenceComparison, parentPrecedence)
-		}
-
-	case symbol.And:
-		// And(a, b, ...) -> a && b && ...
-		if l.Length() > 1 {
-			return l.formatLeftAssociativeInfix("&&", PrecedenceLogicalAnd, parentPrecedence)
+		if len(args) > 1 {
+			return outputInfix(args, outputOperator{op: " * ", precedence: PrecedenceProduct, nary: true}, parent, inputForm)
+		}
+	case symbol.Blank, symbol.BlankSequence, symbol.BlankNullSequence, symbol.Pattern:
+		if pattern, ok := inputPattern(l); ok {
+			return pattern
+		}
+	case symbol.Optional:
+		// x_:0 and x_. are read back as Optional only when the pattern
+		// is named and the colon follows it directly
+		if pattern, ok := inputPattern(args[0]); ok && args[0].Head() == symbol.Pattern {
+			switch len(args) {
+			case 1:
+				return pattern + "."
+			case 2:
+				return parenthesize(pattern+":"+inputForm(args[1], PrecedenceAlternatives+1), PrecedenceAlternatives, parent)
+			}
 		}
-
-	case symbol.Or:
-		// Or(a, b, ...) -> a || b || ...
-		if l.Length() > 1 {
-			return l.formatLeftAssociativeInfix("||", PrecedenceLogicalOr, parentPrecedence)
+	case symbol.Rule:
+		// x_ : v with a space, as x_:v is an Optional
+		if len(args) == 2 && args[0].Head() == symbol.Pattern {
+			if _, ok := inputPattern(args[0]); ok {
+				return outputInfix(args, outputOperator{op: " : ", precedence: PrecedenceRule}, parent, inputForm)
+			}
 		}
 	}
-
-	// Default: function call format Head(arg1, arg2, ...)
-	var elements []string
-	for _, elem := range l.Tail() {
-		elements = append(elements, elem.InputForm())
+	if op, ok := outputOperators[head]; ok && (len(args) == 2 || op.nary && len(args) > 2) {
+		return outputInfix(args, op, parent, inputForm)
 	}
-	return fmt.Sprintf("%s(%s)", l.Head().String(), strings.Join(elements, ", "))
+	return inputForm(head, PrecedencePostfix) + "(" + inputArguments(args) + ")"
 }
 
-// formatInfixWithParens formats a binary infix operation with parentheses if needed
-func (l List) formatInfixWithParens(op string, opPrecedence, parentPrecedence Precedence) string {
+// inputPattern prints a blank, or a Pattern of a symbol and a blank, in
+// the short form x_Integer
+func inputPattern(e Expr) (string, bool) {
+	if blank, ok := outputBlank(e); ok {
+		return blank, true
+	}
+	l, ok := e.(List)
+	if !ok || l.Head() != symbol.Pattern || l.Length() != 2 {
+		return "", false
+	}
 	args := l.Tail()
-	left := l.getInputFormWithPrecedence(args[0], opPrecedence)
-	right := l.getInputFormWithPrecedence(args[1], opPrecedence)
-	result := fmt.Sprintf("%s %s %s", left, op, right)
-
-	if opPrecedence < parentPrecedence {
-		return fmt.Sprintf("(%s)", result)
+	name, ok := args[0].(Symbol)
+	if !ok {
+		return "", false
 	}
-	return result
-}
-
-// formatLeftAssociativeInfix formats left-associative infix operations like a + b + c
-func (l List) formatLeftAssociativeInfix(op string, opPrecedence, parentPrecedence Precedence) string {
-	var parts []string
-	for _, elem := range l.Tail() {
-		parts = append(parts, l.getInputFormWithPrecedence(elem, opPrecedence+1)) // Higher precedence for right operand
+	blank, ok := outputBlank(args[1])
+	if !ok {
+		return "", false
 	}
-	result := strings.Join(parts, fmt.Sprintf(" %s ", op))
+	return name.String() + blank, true
+}
 
-	if opPrecedence < parentPrecedence {
-		return fmt.Sprintf("(%s)", result)
+// inputRules reports whether every argument is a Rule(k, v)
+func inputRules(args []Expr) bool {
+	for _, arg := range args {
+		if arg.Head() != symbol.Rule || arg.Length() != 2 {
+			return false
+		}
 	}
-	return result
+	return true
 }
 
-// getInputFormWithPrecedence gets InputForm with precedence context for proper parenthesization
-func (l List) getInputFormWithPrecedence(expr Expr, precedence Precedence) string {
-	if list, ok := expr.(List); ok {
-		return list.inputFormWithPrecedence(precedence)
+// inputArguments prints a comma separated argument list
+func inputArguments(args []Expr) string {
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		parts[i] = inputForm(arg, PrecedenceLowest)
 	}
-	return expr.InputForm()
+	return strings.Join(parts, ", ")
 }
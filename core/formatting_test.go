@@ -0,0 +1,46 @@
+package core
+
+import "testing"
+
+func TestInputForm(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Subtract(a, Subtract(b, c))", "a - (b - c)"},
+		{"Subtract(Subtract(a, b), c)", "a - b - c"},
+		{"Divide(a, Divide(b, c))", "a / (b / c)"},
+		{"Rule(a, Rule(b, c))", "a: (b: c)"},
+		{"Rule(Rule(a, b), c)", "a: b: c"},
+		{"Plus(a, Times(-1, b))", "a + (-1) * b"},
+		{"Power(x, -1)", "x^(-1)"},
+		{`f("a", ["b"])`, `f("a", ["b"])`},
+		{"HoldForm(Plus(1, 1))", "HoldForm(1 + 1)"},
+		{"Pattern(x, Blank())", "x_"},
+		{"f(Pattern(x, Blank(Integer)), BlankSequence(), Pattern(y, BlankNullSequence()))", "f(x_Integer, __, y___)"},
+		{"Optional(Pattern(x, Blank()), 0)", "x_:0"},
+		{"Optional(Pattern(x, Blank()))", "x_."},
+		{"Rule(Pattern(x, Blank()), 0)", "x_ : 0"},
+		{"Pattern(x, f(Blank()))", "Pattern(x, f(_))"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			expr, err := ParseString(tt.input)
+			if err != nil {
+				t.Fatalf("ParseString(%q): %v", tt.input, err)
+			}
+			got := expr.InputForm()
+			if got != tt.expected {
+				t.Errorf("InputForm(%s) = %q, want %q", tt.input, got, tt.expected)
+			}
+			back, err := ParseString(got)
+			if err != nil {
+				t.Fatalf("InputForm %q does not parse: %v", got, err)
+			}
+			if !back.Equal(expr) {
+				t.Errorf("InputForm %q parses as %s, want %s", got, back, expr)
+			}
+		})
+	}
+}
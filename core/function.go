@@ -36,7 +36,7 @@ func (f FunctionExpr) String() string {
 
 // InputForm returns the input form representation
 func (f FunctionExpr) InputForm() string {
-	return f.String() // Same as String for now
+	return inputForm(f, PrecedenceLowest)
 }
 
 func (f FunctionExpr) Head() Expr {
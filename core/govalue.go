@@ -0,0 +1,137 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/client9/cardinal/core/symbol"
+)
+
+// ToGoValue converts an expression to plain Go values for use outside
+// the interpreter:
+//
+//   - Integer is int64, Real is float64, String is string
+//   - True and False are bool, Null is nil
+//   - List is []interface{}
+//   - Association is map[string]interface{}, and its keys must be Strings
+//
+// Anything else, such as a symbol, a Rational or an Integer too large for
+// int64, is an error.
+func ToGoValue(e Expr) (interface{}, error) {
+	switch ex := e.(type) {
+	case String:
+		return string(ex), nil
+	case Integer:
+		if !ex.IsInt64() {
+			return nil, fmt.Errorf("%s does not fit in an int64", ex)
+		}
+		return ex.Int64(), nil
+	case Real:
+		return ex.Float64(), nil
+	case Symbol:
+		switch ex {
+		case symbol.True:
+			return true, nil
+		case symbol.False:
+			return false, nil
+		case symbol.Null:
+			return nil, nil
+		}
+	case Association:
+		m := make(map[string]interface{}, ex.Len())
+		for _, key := range ex.Keys() {
+			k, ok := key.(String)
+			if !ok {
+				return nil, fmt.Errorf("association key %s is not a string", key.InputForm())
+			}
+			value, _ := ex.Get(key)
+			v, err := ToGoValue(value)
+			if err != nil {
+				return nil, err
+			}
+			m[string(k)] = v
+		}
+		return m, nil
+	case List:
+		if ex.Head() != symbol.List {
+			break
+		}
+		s := make([]interface{}, 0, ex.Length())
+		for _, elem := range ex.Tail() {
+			v, err := ToGoValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			s = append(s, v)
+		}
+		return s, nil
+	}
+	return nil, fmt.Errorf("%s has no Go value", e.InputForm())
+}
+
+// FromGoValue converts a Go value to an expression, the reverse of
+// ToGoValue. Any integer or float type, string, bool and nil are
+// accepted, as are slices, arrays and string keyed maps of them. Map
+// keys are sorted, since Go maps have no order. An Expr is returned
+// as is.
+func FromGoValue(v interface{}) (Expr, error) {
+	switch x := v.(type) {
+	case nil:
+		return symbol.Null, nil
+	case Expr:
+		return x, nil
+	case bool:
+		return NewBool(x), nil
+	case string:
+		return NewString(x), nil
+	case int:
+		return NewInteger(int64(x)), nil
+	case int64:
+		return NewInteger(x), nil
+	case float64:
+		return NewReal(x), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return NewInteger(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, _ := NewIntegerFromString(strconv.FormatUint(rv.Uint(), 10))
+		return n, nil
+	case reflect.Float32, reflect.Float64:
+		return NewReal(rv.Float()), nil
+	case reflect.Slice, reflect.Array:
+		elements := make([]Expr, 0, rv.Len()+1)
+		elements = append(elements, symbol.List)
+		for i := 0; i < rv.Len(); i++ {
+			elem, err := FromGoValue(rv.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, elem)
+		}
+		return NewListFromExprs(elements...), nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("map key type %s is not a string", rv.Type().Key())
+		}
+		keys := make([]string, 0, rv.Len())
+		for _, k := range rv.MapKeys() {
+			keys = append(keys, k.String())
+		}
+		sort.Strings(keys)
+		assoc := NewAssociation()
+		for _, k := range keys {
+			value, err := FromGoValue(rv.MapIndex(reflect.ValueOf(k).Convert(rv.Type().Key())).Interface())
+			if err != nil {
+				return nil, err
+			}
+			assoc = assoc.Set(NewString(k), value)
+		}
+		return assoc, nil
+	}
+	return nil, fmt.Errorf("cannot convert %T to an expression", v)
+}
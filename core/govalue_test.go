@@ -0,0 +1,85 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/client9/cardinal/core/symbol"
+)
+
+func TestGoValue_RoundTrip(t *testing.T) {
+	values := []interface{}{
+		int64(-3),
+		2.5,
+		"text",
+		true,
+		nil,
+		[]interface{}{},
+		[]interface{}{int64(1), "two", []interface{}{3.0, false}},
+		map[string]interface{}{
+			"name":  "cardinal",
+			"tags":  []interface{}{"cas", "go"},
+			"inner": map[string]interface{}{"n": int64(7), "none": nil},
+		},
+	}
+	for _, v := range values {
+		e, err := FromGoValue(v)
+		if err != nil {
+			t.Fatalf("FromGoValue(%#v): %v", v, err)
+		}
+		back, err := ToGoValue(e)
+		if err != nil {
+			t.Fatalf("ToGoValue(%s): %v", e, err)
+		}
+		if !reflect.DeepEqual(back, v) {
+			t.Errorf("round trip of %#v gave %#v", v, back)
+		}
+	}
+}
+
+func TestFromGoValue(t *testing.T) {
+	tests := []struct {
+		value    interface{}
+		expected string
+	}{
+		{int8(-5), "-5"},
+		{uint64(18446744073709551615), "18446744073709551615"},
+		{float32(0.5), "0.5"},
+		{[]string{"a", "b"}, `List("a", "b")`},
+		{[2]int{1, 2}, "List(1, 2)"},
+		{map[string]int{"b": 2, "a": 1}, `Association(Rule("a", 1), Rule("b", 2))`},
+		{NewSymbol("x"), "x"},
+	}
+	for _, tt := range tests {
+		e, err := FromGoValue(tt.value)
+		if err != nil {
+			t.Fatalf("FromGoValue(%#v): %v", tt.value, err)
+		}
+		if e.String() != tt.expected {
+			t.Errorf("FromGoValue(%#v) = %s, want %s", tt.value, e, tt.expected)
+		}
+	}
+
+	for _, bad := range []interface{}{map[int]string{1: "a"}, struct{}{}, []interface{}{make(chan int)}} {
+		if _, err := FromGoValue(bad); err == nil {
+			t.Errorf("FromGoValue(%#v) should fail", bad)
+		}
+	}
+}
+
+func TestToGoValue_Errors(t *testing.T) {
+	big, _ := NewIntegerFromString("123456789012345678901234567890")
+	bad := []Expr{
+		NewSymbol("x"),
+		ListFrom(symbol.Plus, NewInteger(1), NewSymbol("x")),
+		NewRational(1, 2),
+		big,
+		NewAssociation().Set(NewInteger(1), NewInteger(2)),
+		NewList(symbol.List, NewInteger(1), NewSymbol("y")),
+	}
+	for _, e := range bad {
+		if v, err := ToGoValue(e); err == nil {
+			t.Errorf("ToGoValue(%s) = %#v, want an error", e, v)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package core
+
+import (
+	"math"
+)
+
+// Hash returns a structural hash of an expression: if a.Equal(b) then
+// Hash(a) == Hash(b). Different expressions may share a hash, so a
+// matching hash must still be confirmed with Equal.
+//
+// The hash does not depend on the process, and the hash of a List is
+// computed once and kept with the List.
+func Hash(e Expr) uint64 {
+	switch ex := e.(type) {
+	case List:
+		return ex.Hash()
+	case Number:
+		// Reals are Equal to any number of the same value, 1.0 == 1
+		f := ex.Float64()
+		if f == 0 {
+			f = 0 // -0.0
+		}
+		return hashCombine(hashTagNumber, math.Float64bits(f))
+	case Symbol:
+		return hashString(hashTagSymbol, ex.String())
+	case String:
+		return hashString(hashTagString, string(ex))
+	case Rune:
+		return hashCombine(hashTagRune, uint64(ex))
+	case ByteArray:
+		return hashString(hashTagByteArray, string(ex.data))
+	case SliceFloat64:
+		h := hashTagSliceFloat64
+		for _, f := range ex {
+			if f == 0 {
+				f = 0
+			}
+			h = hashCombine(h, math.Float64bits(f))
+		}
+		return h
+	case Association:
+		// Equal ignores the order of the keys, so the entries are summed
+		h := uint64(0)
+		for _, key := range ex.Keys() {
+			value, _ := ex.Get(key)
+			h += hashCombine(Hash(key), Hash(value))
+		}
+		return hashCombine(hashTagAssociation, h)
+	case FunctionExpr:
+		h := hashTagFunction
+		for _, p := range ex.Parameters {
+			h = hashCombine(h, Hash(p))
+		}
+		return hashCombine(h, Hash(ex.Body))
+	case ErrorExpr:
+		h := hashString(hashTagError, ex.ErrorType)
+		if ex.Arg != nil {
+			h = hashCombine(h, Hash(ex.Arg))
+		}
+		return h
+	case ObjectExpr:
+		return hashCombine(hashString(hashTagObject, ex.TypeName.String()), Hash(ex.Value))
+	}
+	// an unknown kind of expression, Equal can only be trusted by itself
+	return hashTagOther
+}
+
+// Hash returns the structural hash of the List, see the Hash function.
+// It is computed on the first call.
+func (l List) Hash() uint64 {
+	if l.listData == nil {
+		return hashTagList
+	}
+	if h := l.hash.Load(); h != 0 {
+		return h
+	}
+	h := hashTagList
+	for _, e := range l.elements {
+		h = hashCombine(h, Hash(e))
+	}
+	if h == 0 {
+		// 0 means not yet computed
+		h = 1
+	}
+	l.hash.Store(h)
+	return h
+}
+
+// cachedHash returns the hash of the List if it was already computed,
+// otherwise 0
+func (l List) cachedHash() uint64 {
+	if l.listData == nil {
+		return 0
+	}
+	return l.hash.Load()
+}
+
+// Distinct seeds, so that "x" and x, or [] and {} hash differently
+const (
+	hashTagNumber uint64 = iota + 0x9e3779b97f4a7c15
+	hashTagSymbol
+	hashTagString
+	hashTagRune
+	hashTagByteArray
+	hashTagSliceFloat64
+	hashTagAssociation
+	hashTagFunction
+	hashTagError
+	hashTagObject
+	hashTagList
+	hashTagOther
+)
+
+// hashString adds the bytes of s to h, as FNV-1a does
+func hashString(h uint64, s string) uint64 {
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= 1099511628211
+	}
+	return hashCombine(h, uint64(len(s)))
+}
+
+// hashCombine adds x to h. The mixing is the SplitMix64 finalizer.
+func hashCombine(h, x uint64) uint64 {
+	h ^= x + 0x9e3779b97f4a7c15 + (h << 6) + (h >> 2)
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+	return h
+}
+
+// ExprSet is a set of expressions, found by Hash and compared with Equal
+// both ways, so 1 and 1.0 are different members
+type ExprSet struct {
+	buckets map[uint64][]Expr
+}
+
+// NewExprSet returns an empty ExprSet
+func NewExprSet() *ExprSet {
+	return &ExprSet{buckets: make(map[uint64][]Expr)}
+}
+
+// Contains reports whether an expression the same as e was added
+func (s *ExprSet) Contains(e Expr) bool {
+	for _, x := range s.buckets[Hash(e)] {
+		if sameExpr(x, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// Add adds e to the set, and reports whether it was not already there
+func (s *ExprSet) Add(e Expr) bool {
+	h := Hash(e)
+	for _, x := range s.buckets[h] {
+		if sameExpr(x, e) {
+			return false
+		}
+	}
+	s.buckets[h] = append(s.buckets[h], e)
+	return true
+}
+
+// sameExpr is Equal checked both ways. A Real is Equal to any number of
+// the same value, but as keys or members 1 and 1.0 are kept apart.
+func sameExpr(a, b Expr) bool {
+	return a.Equal(b) && b.Equal(a)
+}
@@ -0,0 +1,169 @@
+package core
+
+import (
+	"math"
+	"testing"
+
+	"github.com/client9/cardinal/core/symbol"
+)
+
+// testAssociation builds an Association from key, value pairs
+func testAssociation(kv ...interface{}) Association {
+	a := NewAssociation()
+	for i := 0; i < len(kv); i += 2 {
+		a = a.Set(NewSymbol(kv[i].(string)), kv[i+1].(Expr))
+	}
+	return a
+}
+
+func TestHash_EqualExpressions(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Expr
+	}{
+		{"integers", NewInteger(42), NewInteger(42)},
+		{"real and integer", NewReal(1.0), NewInteger(1)},
+		{"real and rational", NewReal(0.5), NewRational(1, 2)},
+		{"negative zero", NewReal(0.0), NewReal(math.Copysign(0, -1))},
+		{"strings", NewString("abc"), NewString("abc")},
+		{"symbols", NewSymbol("x"), NewSymbol("x")},
+		{"lists", ListFrom(symbol.Plus, NewInteger(1), NewSymbol("x")), ListFrom(symbol.Plus, NewInteger(1), NewSymbol("x"))},
+		{"nested lists", MustParse("f([1, 2.0], g(h(x)))"), MustParse("f([1, 2.0], g(h(x)))")},
+		{"associations in any order", testAssociation("a", NewInteger(1), "b", MustParse("[2]")), testAssociation("b", MustParse("[2]"), "a", NewInteger(1))},
+		{"functions", MustParse("Function(x, x + 1)"), MustParse("Function(x, x + 1)")},
+		{"errors", NewError("DivisionByZero", "a"), NewError("DivisionByZero", "b")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.a.Equal(tt.b) {
+				t.Fatalf("%s and %s should be Equal", tt.a, tt.b)
+			}
+			if Hash(tt.a) != Hash(tt.b) {
+				t.Errorf("Hash(%s) = %x, Hash(%s) = %x", tt.a, Hash(tt.a), tt.b, Hash(tt.b))
+			}
+		})
+	}
+}
+
+func TestHash_Distinguishes(t *testing.T) {
+	exprs := []Expr{
+		NewInteger(1), NewInteger(2), NewString("x"), NewSymbol("x"),
+		MustParse("[]"), NewAssociation(), MustParse("[1, 2]"), MustParse("[2, 1]"),
+		MustParse("[[1], 2]"), MustParse("[1, [2]]"), MustParse("f(x)"), MustParse("g(x)"),
+		testAssociation("a", NewInteger(1)), testAssociation("a", NewInteger(2)), NewString("ab"), NewString("ba"),
+	}
+	seen := make(map[uint64]Expr)
+	for _, e := range exprs {
+		h := Hash(e)
+		if other, ok := seen[h]; ok {
+			t.Errorf("%s and %s have the same hash %x", e.InputForm(), other.InputForm(), h)
+		}
+		seen[h] = e
+	}
+}
+
+func TestHash_CachedOnList(t *testing.T) {
+	a := MustParse("f([1, 2, 3], x)").(List)
+	b := MustParse("f([1, 2, 3], y)").(List)
+	c := MustParse("f([1, 2, 3], x)").(List)
+
+	if a.cachedHash() != 0 {
+		t.Fatal("the hash should not be computed before it is asked for")
+	}
+	h := a.Hash()
+	if a.cachedHash() != h {
+		t.Errorf("the hash should be kept, got %x want %x", a.cachedHash(), h)
+	}
+	// copies of a List share the hash
+	var e Expr = a
+	if e.(List).cachedHash() != h {
+		t.Error("a copy of the List should see the hash")
+	}
+
+	b.Hash()
+	if a.Equal(b) || b.Equal(a) {
+		t.Error("lists with different hashes should not be Equal")
+	}
+	c.Hash()
+	if !a.Equal(c) {
+		t.Error("lists with the same hash should still be compared")
+	}
+}
+
+func TestHash_ZeroList(t *testing.T) {
+	// the zero List is left by a failed type assertion and must not panic
+	var l List
+	if l.Length() != 0 || l.String() != "List()" || len(l.AsSlice()) != 0 {
+		t.Errorf("zero List: Length %d, String %q", l.Length(), l.String())
+	}
+	if l.Hash() == 0 || l.Equal(ListFrom(symbol.List)) {
+		t.Errorf("zero List: Hash %d, Equal to List()", l.Hash())
+	}
+	if l.Copy().Length() != 0 {
+		t.Errorf("zero List: Copy has length %d", l.Copy().Length())
+	}
+}
+
+func TestExprSet(t *testing.T) {
+	s := NewExprSet()
+	for _, tt := range []struct {
+		e     Expr
+		added bool
+	}{
+		{MustParse("[1, 2]"), true},
+		{MustParse("[1, 2]"), false},
+		{NewInteger(1), true},
+		{NewReal(1.0), true},
+		{NewInteger(1), false},
+		{testAssociation("a", NewInteger(1), "b", NewInteger(2)), true},
+		{testAssociation("b", NewInteger(2), "a", NewInteger(1)), false},
+	} {
+		if got := s.Add(tt.e); got != tt.added {
+			t.Errorf("Add(%s) = %v, want %v", tt.e.InputForm(), got, tt.added)
+		}
+	}
+	if !s.Contains(MustParse("[1, 2]")) || s.Contains(MustParse("[2, 1]")) {
+		t.Error("Contains does not match what was added")
+	}
+}
+
+// nestedList builds f(f(0, 1, ...), ...) depth levels deep, with last
+// as the final atom so two trees can differ only at the very end
+func nestedList(depth, width int, last Expr) Expr {
+	if depth == 0 {
+		return last
+	}
+	args := make([]Expr, width)
+	for i := range args {
+		args[i] = nestedList(depth-1, width, NewInteger(int64(i)))
+	}
+	args[width-1] = nestedList(depth-1, width, last)
+	return ListFrom(NewSymbol("f"), args...)
+}
+
+// BenchmarkListEqual compares two large trees that differ in their last
+// leaf, element by element
+func BenchmarkListEqual(b *testing.B) {
+	x := nestedList(5, 8, NewSymbol("x"))
+	y := nestedList(5, 8, NewSymbol("y"))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if x.Equal(y) {
+			b.Fatal("should differ")
+		}
+	}
+}
+
+// BenchmarkListEqualHashed is BenchmarkListEqual once the hashes are known
+func BenchmarkListEqualHashed(b *testing.B) {
+	x := nestedList(5, 8, NewSymbol("x"))
+	y := nestedList(5, 8, NewSymbol("y"))
+	Hash(x)
+	Hash(y)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if x.Equal(y) {
+			b.Fatal("should differ")
+		}
+	}
+}
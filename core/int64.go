@@ -14,11 +14,29 @@ func MustInt64(e Expr) int64 {
 	return e.(Integer).Int64()
 }
 
+// Integers from smallIntegerMin to smallIntegerMax are boxed once and
+// shared, so making one does not allocate. Go only avoids the allocation
+// for 0 to 255 by itself.
+const (
+	smallIntegerMin = -128
+	smallIntegerMax = 256
+)
+
+var smallIntegers = func() (ints [smallIntegerMax - smallIntegerMin + 1]Integer) {
+	for i := range ints {
+		ints[i] = machineInt(i + smallIntegerMin)
+	}
+	return ints
+}()
+
 func NewInteger(n int64) Integer {
 	return newMachineInt(n)
 }
 
-func newMachineInt(i int64) machineInt {
+func newMachineInt(i int64) Integer {
+	if i >= smallIntegerMin && i <= smallIntegerMax {
+		return smallIntegers[i-smallIntegerMin]
+	}
 	return machineInt(i)
 }
 
@@ -83,9 +101,12 @@ func (i machineInt) AsBigInt() *big.Int {
 }
 
 func (i machineInt) AsNeg() Expr {
-	return machineInt(-i)
+	return newMachineInt(-int64(i))
 }
 func (i machineInt) AsInv() Expr {
+	if i == 1 || i == -1 {
+		return i
+	}
 	if i > 0 {
 		return rat64{1, int64(i)}
 	}
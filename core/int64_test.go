@@ -0,0 +1,36 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestNewInteger_Small(t *testing.T) {
+	for _, n := range []int64{smallIntegerMin - 1, smallIntegerMin, -1, 0, 1, 255, smallIntegerMax, smallIntegerMax + 1, 1 << 40} {
+		a := NewInteger(n)
+		b := NewInteger(n)
+		if !a.Equal(b) || a.Int64() != n {
+			t.Errorf("NewInteger(%d) gave %s and %s", n, a, b)
+		}
+		if got := a.AsNeg(); !got.Equal(NewInteger(-n)) {
+			t.Errorf("AsNeg of %d is %s", n, got)
+		}
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		for n := int64(smallIntegerMin); n <= smallIntegerMax; n++ {
+			NewInteger(n)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("making small integers allocated %v times", allocs)
+	}
+}
+
+// BenchmarkPlusSmallIntegers adds small negative and positive integers,
+// whose sums are shared values
+func BenchmarkPlusSmallIntegers(b *testing.B) {
+	args := []Expr{NewInteger(-100), NewInteger(-20), NewInteger(3)}
+	for b.Loop() {
+		PlusList(args)
+	}
+}
@@ -0,0 +1,222 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/client9/cardinal/core/symbol"
+)
+
+// ToJSON encodes an expression as JSON:
+//
+//   - Integers and Reals are numbers, Strings are strings
+//   - True and False are booleans, Null is null
+//   - Lists are arrays and Associations are objects. Keys that are not
+//     strings are written in InputForm
+//   - Other symbols are {"symbol": "x"}
+//   - Other expressions are {"head": "Plus", "args": [1, 2]}
+//
+// Values JSON has no number for, like infinite Reals, are an error.
+func ToJSON(e Expr) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeJSON(&buf, e); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeJSON(buf *bytes.Buffer, e Expr) error {
+	switch ex := e.(type) {
+	case String:
+		writeJSONString(buf, string(ex))
+	case Integer:
+		buf.WriteString(ex.String())
+	case Real:
+		s, err := jsonReal(ex)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(s)
+	case Symbol:
+		switch ex {
+		case symbol.True:
+			buf.WriteString("true")
+		case symbol.False:
+			buf.WriteString("false")
+		case symbol.Null:
+			buf.WriteString("null")
+		default:
+			buf.WriteString(`{"symbol":`)
+			writeJSONString(buf, ex.String())
+			buf.WriteByte('}')
+		}
+	case Association:
+		buf.WriteByte('{')
+		for i, key := range ex.Keys() {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if s, ok := key.(String); ok {
+				writeJSONString(buf, string(s))
+			} else {
+				writeJSONString(buf, key.InputForm())
+			}
+			buf.WriteByte(':')
+			value, _ := ex.Get(key)
+			if err := writeJSON(buf, value); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	case List:
+		if ex.Head() == symbol.List {
+			return writeJSONArray(buf, ex.Tail())
+		}
+		buf.WriteString(`{"head":`)
+		if h, ok := ex.Head().(Symbol); ok {
+			writeJSONString(buf, h.String())
+		} else if err := writeJSON(buf, ex.Head()); err != nil {
+			return err
+		}
+		buf.WriteString(`,"args":`)
+		if err := writeJSONArray(buf, ex.Tail()); err != nil {
+			return err
+		}
+		buf.WriteByte('}')
+	case Rational:
+		buf.WriteString(`{"head":"Rational","args":[`)
+		buf.WriteString(ex.AsNum().String())
+		buf.WriteByte(',')
+		buf.WriteString(ex.AsDenom().String())
+		buf.WriteString("]}")
+	default:
+		return fmt.Errorf("%s has no JSON form", e.Head())
+	}
+	return nil
+}
+
+func writeJSONArray(buf *bytes.Buffer, elements []Expr) error {
+	buf.WriteByte('[')
+	for i, elem := range elements {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeJSON(buf, elem); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+	return nil
+}
+
+func writeJSONString(buf *bytes.Buffer, s string) {
+	// a string always encodes
+	b, _ := json.Marshal(s)
+	buf.Write(b)
+}
+
+// jsonReal formats a Real so it reads back as a Real, 3.0 and not 3
+func jsonReal(r Real) (string, error) {
+	if !r.IsFloat64() {
+		s := r.String()
+		if strings.Contains(s, "Inf") || strings.Contains(s, "NaN") {
+			return "", fmt.Errorf("%s has no JSON form", s)
+		}
+		return s, nil
+	}
+	f := r.Float64()
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return "", fmt.Errorf("%s has no JSON form", r.String())
+	}
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return s, nil
+}
+
+// FromJSON decodes a JSON document: objects are Associations with String
+// keys in document order, arrays are Lists, numbers with a fraction or
+// exponent are Reals and other numbers Integers, true and false are True
+// and False, and null is Null. Tagged objects written by ToJSON stay
+// Associations.
+func FromJSON(data []byte) (Expr, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	e, err := readJSON(dec)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after the JSON value")
+	}
+	return e, nil
+}
+
+func readJSON(dec *json.Decoder) (Expr, error) {
+	tok, err := dec.Token()
+	if err == io.EOF {
+		return nil, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch t := tok.(type) {
+	case json.Delim:
+		if t == '[' {
+			elements := []Expr{symbol.List}
+			for dec.More() {
+				elem, err := readJSON(dec)
+				if err != nil {
+					return nil, err
+				}
+				elements = append(elements, elem)
+			}
+			if _, err := dec.Token(); err != nil {
+				return nil, err
+			}
+			return NewListFromExprs(elements...), nil
+		}
+		// the decoder only gives a '{' here, it checks the nesting
+		assoc := NewAssociation()
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := readJSON(dec)
+			if err != nil {
+				return nil, err
+			}
+			assoc = assoc.Set(NewString(key.(string)), value)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return assoc, nil
+	case json.Number:
+		return jsonNumber(t.String())
+	case string:
+		return NewString(t), nil
+	case bool:
+		return NewBool(t), nil
+	}
+	return symbol.Null, nil
+}
+
+// jsonNumber converts a JSON number to an Integer if it has no fraction
+// or exponent, and to a Real otherwise
+func jsonNumber(s string) (Expr, error) {
+	if !strings.ContainsAny(s, ".eE") {
+		if n, ok := NewIntegerFromString(s); ok {
+			return n, nil
+		}
+	}
+	return ParseReal(s)
+}
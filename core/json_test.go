@@ -0,0 +1,73 @@
+package core
+
+import (
+	"math"
+	"testing"
+
+	"github.com/client9/cardinal/core/symbol"
+)
+
+func TestToJSON(t *testing.T) {
+	assoc := NewAssociation().
+		Set(NewString("list"), NewList(symbol.List, NewInteger(1), NewReal(2), NewString("x"))).
+		Set(NewSymbol("ok"), symbol.True)
+	tests := []struct {
+		name     string
+		expr     Expr
+		expected string
+	}{
+		{"integer", NewInteger(-7), `-7`},
+		{"real keeps its point", NewReal(2), `2.0`},
+		{"string", NewString("a\nb"), `"a\nb"`},
+		{"null", symbol.Null, `null`},
+		{"association", assoc, `{"list":[1,2.0,"x"],"ok":true}`},
+		{"expression", ListFrom(symbol.Plus, NewInteger(1), NewSymbol("y")), `{"head":"Plus","args":[1,{"symbol":"y"}]}`},
+		{"empty list", NewList(symbol.List), `[]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := ToJSON(tt.expr)
+			if err != nil {
+				t.Fatalf("ToJSON(%s): %v", tt.expr, err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("ToJSON(%s) = %s, want %s", tt.expr, data, tt.expected)
+			}
+		})
+	}
+
+	if _, err := ToJSON(NewList(symbol.List, NewReal(math.NaN()))); err == nil {
+		t.Error("ToJSON(NaN) should fail")
+	}
+}
+
+func TestFromJSON(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`42`, `42`},
+		{`-1.5e-3`, `-0.0015`},
+		{`"aé"`, `"aé"`},
+		{`[true, null, []]`, `List(True, Null, List())`},
+		{`{"z": 1, "a": {}}`, `Association(Rule("z", 1), Rule("a", Association()))`},
+		{`{"head": "Plus", "args": [1, 2]}`, `Association(Rule("head", "Plus"), Rule("args", List(1, 2)))`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			e, err := FromJSON([]byte(tt.input))
+			if err != nil {
+				t.Fatalf("FromJSON(%s): %v", tt.input, err)
+			}
+			if e.String() != tt.expected {
+				t.Errorf("FromJSON(%s) = %s, want %s", tt.input, e, tt.expected)
+			}
+		})
+	}
+
+	for _, bad := range []string{``, `[1,`, `{"a": }`, `{1: 2}`, `1 2`} {
+		if _, err := FromJSON([]byte(bad)); err == nil {
+			t.Errorf("FromJSON(%q) should fail", bad)
+		}
+	}
+}
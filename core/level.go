@@ -0,0 +1,136 @@
+package core
+
+import (
+	"math"
+
+	"github.com/client9/cardinal/core/symbol"
+)
+
+// LevelSpec is an inclusive range of levels.  Level 0 is the whole
+// expression, level 1 its elements and so on.  A negative level -n
+// counts from the bottom and means the parts with Depth n, so level -1
+// is the atoms.
+type LevelSpec struct {
+	Min, Max int64
+}
+
+// Contains reports whether a part at the given level, with the given
+// depth, is in the spec.
+func (s LevelSpec) Contains(level, depth int64) bool {
+	lo := level >= s.Min
+	if s.Min < 0 {
+		lo = -depth >= s.Min
+	}
+	hi := level <= s.Max
+	if s.Max < 0 {
+		hi = -depth <= s.Max
+	}
+	return lo && hi
+}
+
+// descend reports whether parts below level can be in the spec.
+// Negative bounds depend on depth so always need the whole tree.
+func (s LevelSpec) descend(level int64) bool {
+	return s.Min < 0 || s.Max < 0 || level < s.Max
+}
+
+// ParseLevelSpec understands n (levels 1 through n), Infinity,
+// [n] (only level n) and [min, max], where each level may be
+// negative or Infinity.
+func ParseLevelSpec(expr Expr) (LevelSpec, bool) {
+	level := func(x Expr) (int64, bool) {
+		if x == symbol.Infinity {
+			return math.MaxInt64, true
+		}
+		n, ok := x.(Integer)
+		if !ok || !n.IsInt64() {
+			return 0, false
+		}
+		return n.Int64(), true
+	}
+
+	list, ok := expr.(List)
+	if !ok || list.Head() != symbol.List {
+		n, ok := level(expr)
+		return LevelSpec{1, n}, ok
+	}
+	args := list.Tail()
+	switch len(args) {
+	case 1:
+		n, ok := level(args[0])
+		return LevelSpec{n, n}, ok
+	case 2:
+		lo, ok1 := level(args[0])
+		hi, ok2 := level(args[1])
+		return LevelSpec{lo, hi}, ok1 && ok2
+	}
+	return LevelSpec{}, false
+}
+
+// Depth is the number of levels of nesting plus one, so atoms have
+// depth 1.  Heads are not counted.
+func Depth(expr Expr) int64 {
+	list, ok := expr.(List)
+	if !ok {
+		return 1
+	}
+	depth := int64(0)
+	for _, elem := range list.Tail() {
+		depth = max(depth, Depth(elem))
+	}
+	return depth + 1
+}
+
+// WalkLevels calls fn on each part of expr in the spec, with its
+// 1-based position.  Parts are visited depth first, children before
+// their parent.
+func WalkLevels(expr Expr, spec LevelSpec, fn func(part Expr, pos []int64)) {
+	walkLevels(expr, spec, nil, fn)
+}
+
+func walkLevels(expr Expr, spec LevelSpec, pos []int64, fn func(Expr, []int64)) int64 {
+	level := int64(len(pos))
+	depth := int64(1)
+	if list, ok := expr.(List); ok && spec.descend(level) {
+		for i, elem := range list.Tail() {
+			childPos := append(pos[:len(pos):len(pos)], int64(i+1))
+			depth = max(depth, walkLevels(elem, spec, childPos, fn)+1)
+		}
+	}
+	if spec.Contains(level, depth) {
+		fn(expr, pos)
+	}
+	return depth
+}
+
+// MapLevels rebuilds expr with each part in the spec replaced by
+// fn(part, pos).  Children are replaced before their parent, and depth
+// is measured on the original parts.  An Error from fn stops the walk
+// and is returned.
+func MapLevels(expr Expr, spec LevelSpec, fn func(part Expr, pos []int64) Expr) Expr {
+	result, _ := mapLevels(expr, spec, nil, fn)
+	return result
+}
+
+func mapLevels(expr Expr, spec LevelSpec, pos []int64, fn func(Expr, []int64) Expr) (Expr, int64) {
+	level := int64(len(pos))
+	depth := int64(1)
+	if list, ok := expr.(List); ok && spec.descend(level) {
+		elements := list.Tail()
+		result := make([]Expr, len(elements))
+		for i, elem := range elements {
+			childPos := append(pos[:len(pos):len(pos)], int64(i+1))
+			var d int64
+			result[i], d = mapLevels(elem, spec, childPos, fn)
+			if IsError(result[i]) {
+				return result[i], 0
+			}
+			depth = max(depth, d+1)
+		}
+		expr = ListFrom(list.Head(), result...)
+	}
+	if !spec.Contains(level, depth) {
+		return expr, depth
+	}
+	return fn(expr, pos), depth
+}
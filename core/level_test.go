@@ -0,0 +1,56 @@
+package core
+
+import (
+	"math"
+	"testing"
+
+	"github.com/client9/cardinal/core/symbol"
+)
+
+func TestLevelSpecContains(t *testing.T) {
+	cases := []struct {
+		name  string
+		spec  LevelSpec
+		level int64
+		depth int64
+		want  bool
+	}{
+		{"positive inside", LevelSpec{1, 2}, 2, 1, true},
+		{"positive above", LevelSpec{1, 2}, 0, 3, false},
+		{"positive below", LevelSpec{1, 2}, 3, 1, false},
+		{"infinite", LevelSpec{1, math.MaxInt64}, 100, 1, true},
+		{"atoms", LevelSpec{-1, -1}, 5, 1, true},
+		{"not atoms", LevelSpec{-1, -1}, 1, 2, false},
+		{"mixed inside", LevelSpec{1, -2}, 1, 2, true},
+		{"mixed too shallow", LevelSpec{1, -2}, 2, 1, false},
+		{"mixed whole expression", LevelSpec{1, -2}, 0, 3, false},
+	}
+	for _, tc := range cases {
+		if got := tc.spec.Contains(tc.level, tc.depth); got != tc.want {
+			t.Errorf("%s: %v.Contains(%d, %d) = %v, want %v", tc.name, tc.spec, tc.level, tc.depth, got, tc.want)
+		}
+	}
+}
+
+func TestParseLevelSpec(t *testing.T) {
+	cases := []struct {
+		name string
+		expr Expr
+		want LevelSpec
+		ok   bool
+	}{
+		{"n", NewInteger(2), LevelSpec{1, 2}, true},
+		{"negative n", NewInteger(-1), LevelSpec{1, -1}, true},
+		{"Infinity", symbol.Infinity, LevelSpec{1, math.MaxInt64}, true},
+		{"[n]", ListFrom(symbol.List, NewInteger(3)), LevelSpec{3, 3}, true},
+		{"[m, n]", ListFrom(symbol.List, NewInteger(0), symbol.Infinity), LevelSpec{0, math.MaxInt64}, true},
+		{"string", NewString("x"), LevelSpec{}, false},
+		{"too long", ListFrom(symbol.List, NewInteger(1), NewInteger(2), NewInteger(3)), LevelSpec{}, false},
+	}
+	for _, tc := range cases {
+		got, ok := ParseLevelSpec(tc.expr)
+		if ok != tc.ok || (ok && got != tc.want) {
+			t.Errorf("%s: ParseLevelSpec(%s) = %v, %v, want %v, %v", tc.name, tc.expr, got, ok, tc.want, tc.ok)
+		}
+	}
+}
@@ -22,7 +22,16 @@ const (
 	RBRACE
 	COMMA
 	COLON
-	RULEDELAYED // =>
+	RULEDELAYED  // =>
+	REPLACEALL   // /.
+	CONDITION    // /;
+	QUESTION     // ?
+	PIPE         // | (alternatives)
+	TILDE        // ~ (user-defined operator)
+	STARSTAR     // ** (user-defined operator)
+	DIAMOND      // <> (user-defined operator)
+	REPEATED     // ..
+	REPEATEDNULL // ...
 	PLUS
 	MINUS
 	MULTIPLY
@@ -32,6 +41,8 @@ const (
 	SET
 	SETDELAYED
 	UNSET
+	UPSET        // ^=
+	UPSETDELAYED // ^:=
 	EQUAL
 	UNEQUAL
 	LESS
@@ -47,6 +58,8 @@ const (
 	AMPERSAND // &
 	SEMICOLON
 	UNDERSCORE // _
+	DOT        // . after a pattern, x_.
+	PERCENT    // %, %% or %n for an earlier result
 	WHITESPACE
 	ILLEGAL
 )
@@ -86,6 +99,24 @@ func (t Token) String() string {
 		return "COLON"
 	case RULEDELAYED:
 		return "RULEDELAYED"
+	case REPLACEALL:
+		return "REPLACEALL"
+	case CONDITION:
+		return "CONDITION"
+	case QUESTION:
+		return "QUESTION"
+	case PIPE:
+		return "PIPE"
+	case TILDE:
+		return "TILDE"
+	case STARSTAR:
+		return "STARSTAR"
+	case DIAMOND:
+		return "DIAMOND"
+	case REPEATED:
+		return "REPEATED"
+	case REPEATEDNULL:
+		return "REPEATEDNULL"
 	case PLUS:
 		return "PLUS"
 	case MINUS:
@@ -104,6 +135,10 @@ func (t Token) String() string {
 		return "SETDELAYED"
 	case UNSET:
 		return "UNSET"
+	case UPSET:
+		return "UPSET"
+	case UPSETDELAYED:
+		return "UPSETDELAYED"
 	case EQUAL:
 		return "EQUAL"
 	case UNEQUAL:
@@ -132,6 +167,10 @@ func (t Token) String() string {
 		return "AMPERSAND"
 	case UNDERSCORE:
 		return "UNDERSCORE"
+	case DOT:
+		return "DOT"
+	case PERCENT:
+		return fmt.Sprintf("PERCENT(%s)", t.Value)
 	case WHITESPACE:
 		return "WHITESPACE"
 	case ILLEGAL:
@@ -274,6 +313,22 @@ func (l *Lexer) readUnderscores() string {
 	return l.input[position : l.position-l.width]
 }
 
+// readPercent reads a run of %, or a single % followed by digits
+func (l *Lexer) readPercent() string {
+	position := l.position - l.width
+	l.readChar()
+	if isDigit(l.ch) {
+		for isDigit(l.ch) {
+			l.readChar()
+		}
+	} else {
+		for l.ch == '%' {
+			l.readChar()
+		}
+	}
+	return l.input[position : l.position-l.width]
+}
+
 func (l *Lexer) readNumber() (string, TokenType) {
 	position := l.position - l.width
 	tokenType := INTEGER
@@ -325,11 +380,67 @@ func (l *Lexer) NextToken() Token {
 	case '-':
 		tok = Token{Type: MINUS, Value: string(l.ch), Position: l.position - 1}
 	case '*':
-		tok = Token{Type: MULTIPLY, Value: string(l.ch), Position: l.position - 1}
+		if l.peekChar() == '*' {
+			tok = Token{Type: STARSTAR, Value: "**", Position: l.position - 1}
+			l.readChar() // consume first '*'
+			l.readChar() // consume second '*'
+			return tok
+		} else {
+			tok = Token{Type: MULTIPLY, Value: string(l.ch), Position: l.position - 1}
+		}
+	case '~':
+		tok = Token{Type: TILDE, Value: string(l.ch), Position: l.position - 1}
 	case '/':
-		tok = Token{Type: DIVIDE, Value: string(l.ch), Position: l.position - 1}
+		if l.peekChar() == '.' {
+			tok = Token{Type: REPLACEALL, Value: "/.", Position: l.position - 1}
+			l.readChar() // consume '/'
+			l.readChar() // consume '.'
+			return tok
+		} else if l.peekChar() == ';' {
+			tok = Token{Type: CONDITION, Value: "/;", Position: l.position - 1}
+			l.readChar() // consume '/'
+			l.readChar() // consume ';'
+			return tok
+		} else {
+			tok = Token{Type: DIVIDE, Value: string(l.ch), Position: l.position - 1}
+		}
+	case '?':
+		tok = Token{Type: QUESTION, Value: string(l.ch), Position: l.position - 1}
+	case '.':
+		position := l.position - 1
+		if l.peekChar() == '.' {
+			l.readChar() // move to second '.'
+			if l.peekChar() == '.' {
+				l.readChar() // move to third '.'
+				l.readChar() // move past third '.'
+				tok = Token{Type: REPEATEDNULL, Value: "...", Position: position}
+				return tok
+			}
+			l.readChar() // move past second '.'
+			tok = Token{Type: REPEATED, Value: "..", Position: position}
+			return tok
+		}
+		tok = Token{Type: DOT, Value: string(l.ch), Position: position}
 	case '^':
-		tok = Token{Type: CARET, Value: string(l.ch), Position: l.position - 1}
+		position := l.position - 1
+		if l.peekChar() == '=' {
+			l.readChar() // move to '='
+			l.readChar() // move past '='
+			tok = Token{Type: UPSET, Value: "^=", Position: position}
+			return tok
+		}
+		if l.peekChar() == ':' {
+			l.readChar() // move to ':'
+			if l.peekChar() == '=' {
+				l.readChar() // move to '='
+				l.readChar() // move past '='
+				tok = Token{Type: UPSETDELAYED, Value: "^:=", Position: position}
+				return tok
+			}
+			tok = Token{Type: ILLEGAL, Value: "^:", Position: position}
+			return tok
+		}
+		tok = Token{Type: CARET, Value: string(l.ch), Position: position}
 	case '(':
 		tok = Token{Type: LPAREN, Value: string(l.ch), Position: l.position - 1}
 	case ')':
@@ -396,6 +507,11 @@ func (l *Lexer) NextToken() Token {
 			l.readChar() // consume '<'
 			l.readChar() // consume '='
 			return tok
+		} else if l.peekChar() == '>' {
+			tok = Token{Type: DIAMOND, Value: "<>", Position: l.position - 1}
+			l.readChar() // consume '<'
+			l.readChar() // consume '>'
+			return tok
 		} else {
 			tok = Token{Type: LESS, Value: string(l.ch), Position: l.position - 1}
 		}
@@ -424,7 +540,7 @@ func (l *Lexer) NextToken() Token {
 			l.readChar() // consume second '|'
 			return tok
 		} else {
-			tok = Token{Type: ILLEGAL, Value: string(l.ch), Position: l.position - 1}
+			tok = Token{Type: PIPE, Value: string(l.ch), Position: l.position - 1}
 		}
 	case '"':
 		tok.Type = STRING
@@ -445,6 +561,11 @@ func (l *Lexer) NextToken() Token {
 			tok = Token{Type: UNDERSCORE, Value: underscoreValue, Position: tok.Position}
 		}
 		return tok
+	case '%':
+		tok.Position = l.position - l.width
+		tok.Type = PERCENT
+		tok.Value = l.readPercent()
+		return tok
 	case 0:
 		tok.Type = EOF
 		tok.Value = ""
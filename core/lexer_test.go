@@ -154,6 +154,20 @@ func TestLexer_NextToken(t *testing.T) {
 				{Type: EOF, Value: ""},
 			},
 		},
+		{
+			name:  "upset operators",
+			input: "a ^= b ^:= c ^ d",
+			expected: []Token{
+				{Type: SYMBOL, Value: "a"},
+				{Type: UPSET, Value: "^="},
+				{Type: SYMBOL, Value: "b"},
+				{Type: UPSETDELAYED, Value: "^:="},
+				{Type: SYMBOL, Value: "c"},
+				{Type: CARET, Value: "^"},
+				{Type: SYMBOL, Value: "d"},
+				{Type: EOF, Value: ""},
+			},
+		},
 		{
 			name:  "comparison operators",
 			input: "x == y != z < a > b <= c >= d",
@@ -308,6 +322,83 @@ func TestLexer_NextToken(t *testing.T) {
 				{Type: EOF, Value: ""},
 			},
 		},
+		{
+			name:  "ReplaceAll vs Divide",
+			input: "x /. y / z",
+			expected: []Token{
+				{Type: SYMBOL, Value: "x"},
+				{Type: REPLACEALL, Value: "/."},
+				{Type: SYMBOL, Value: "y"},
+				{Type: DIVIDE, Value: "/"},
+				{Type: SYMBOL, Value: "z"},
+				{Type: EOF, Value: ""},
+			},
+		},
+		{
+			name:  "Condition",
+			input: "x_ /; x",
+			expected: []Token{
+				{Type: SYMBOL, Value: "x"},
+				{Type: UNDERSCORE, Value: "_"},
+				{Type: CONDITION, Value: "/;"},
+				{Type: SYMBOL, Value: "x"},
+				{Type: EOF, Value: ""},
+			},
+		},
+		{
+			name:  "PatternTest",
+			input: "x_?f",
+			expected: []Token{
+				{Type: SYMBOL, Value: "x"},
+				{Type: UNDERSCORE, Value: "_"},
+				{Type: QUESTION, Value: "?"},
+				{Type: SYMBOL, Value: "f"},
+				{Type: EOF, Value: ""},
+			},
+		},
+		{
+			name:  "User-defined operator tokens",
+			input: "a ~ b ** c <> d * e < f",
+			expected: []Token{
+				{Type: SYMBOL, Value: "a"},
+				{Type: TILDE, Value: "~"},
+				{Type: SYMBOL, Value: "b"},
+				{Type: STARSTAR, Value: "**"},
+				{Type: SYMBOL, Value: "c"},
+				{Type: DIAMOND, Value: "<>"},
+				{Type: SYMBOL, Value: "d"},
+				{Type: MULTIPLY, Value: "*"},
+				{Type: SYMBOL, Value: "e"},
+				{Type: LESS, Value: "<"},
+				{Type: SYMBOL, Value: "f"},
+				{Type: EOF, Value: ""},
+			},
+		},
+		{
+			name:  "Repeated and RepeatedNull",
+			input: "x_.. 1... .",
+			expected: []Token{
+				{Type: SYMBOL, Value: "x"},
+				{Type: UNDERSCORE, Value: "_"},
+				{Type: REPEATED, Value: ".."},
+				{Type: INTEGER, Value: "1"},
+				{Type: REPEATEDNULL, Value: "..."},
+				{Type: DOT, Value: "."},
+				{Type: EOF, Value: ""},
+			},
+		},
+		{
+			name:  "PIPE vs OR",
+			input: "a | b || c",
+			expected: []Token{
+				{Type: SYMBOL, Value: "a"},
+				{Type: PIPE, Value: "|"},
+				{Type: SYMBOL, Value: "b"},
+				{Type: OR, Value: "||"},
+				{Type: SYMBOL, Value: "c"},
+				{Type: EOF, Value: ""},
+			},
+		},
 		{
 			name:  "Single NOT token",
 			input: "!",
@@ -353,6 +444,20 @@ func TestLexer_NextToken(t *testing.T) {
 				{Type: EOF, Value: ""},
 			},
 		},
+		{
+			name:  "earlier results",
+			input: "% %% %%% %12 %*2",
+			expected: []Token{
+				{Type: PERCENT, Value: "%"},
+				{Type: PERCENT, Value: "%%"},
+				{Type: PERCENT, Value: "%%%"},
+				{Type: PERCENT, Value: "%12"},
+				{Type: PERCENT, Value: "%"},
+				{Type: MULTIPLY, Value: "*"},
+				{Type: INTEGER, Value: "2"},
+				{Type: EOF, Value: ""},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -510,6 +615,11 @@ func TestToken_String(t *testing.T) {
 			token:    Token{Type: UNSET, Value: "=."},
 			expected: "UNSET",
 		},
+		{
+			name:     "upsetdelayed token",
+			token:    Token{Type: UPSETDELAYED, Value: "^:="},
+			expected: "UPSETDELAYED",
+		},
 		{
 			name:     "equal token",
 			token:    Token{Type: EQUAL, Value: "=="},
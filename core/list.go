@@ -3,13 +3,43 @@ package core
 import (
 	"fmt"
 	"strings"
+	"sync/atomic"
 
 	"github.com/client9/cardinal/core/symbol"
 )
 
 // List represents compound expressions
+//
+// A List is a single pointer, so it is stored in an Expr without a copy
+// and all copies share the hash computed by Hash. The zero List, as left
+// by a failed type assertion, behaves as an empty List in Length, String,
+// AsSlice, Copy, Equal and Hash.
 type List struct {
-	elements []Expr
+	*listData
+}
+
+type listData struct {
+	elements  []Expr
+	hash      atomic.Uint64 // 0 until computed, see Hash
+	evaluated atomic.Uint64 // 0 until known, see MarkEvaluated
+}
+
+// newList wraps elements, which must not be changed afterwards
+func newList(elements []Expr) List {
+	return List{&listData{elements: elements}}
+}
+
+// MarkEvaluated records that the List evaluates to itself, as long as
+// the state of the evaluator is still version. Versions are chosen by
+// the evaluator and must not be 0.
+func (l List) MarkEvaluated(version uint64) {
+	l.evaluated.Store(version)
+}
+
+// IsEvaluated reports whether the List was marked by MarkEvaluated with
+// this version
+func (l List) IsEvaluated(version uint64) bool {
+	return version != 0 && l.listData != nil && l.evaluated.Load() == version
 }
 
 func NewList(head Expr, args ...Expr) List {
@@ -20,26 +50,29 @@ func ListFrom(head Expr, args ...Expr) List {
 	elements := make([]Expr, len(args)+1)
 	elements[0] = head
 	copy(elements[1:], args)
-	return List{elements: elements}
+	return newList(elements)
 }
 
 // NewListFromExprs creates a List directly from expressions (for special cases)
 // Use NewList instead when possible, as it enforces the Symbol-head convention
 func NewListFromExprs(elements ...Expr) List {
-	return List{elements: elements}
+	return newList(elements)
 }
 
 // Copy does a shallow clone of the List
 // TBD if this should return List or Expr
 func (l List) Copy() List {
+	if l.listData == nil {
+		return newList(nil)
+	}
 	newelements := make([]Expr, len(l.elements))
 	copy(newelements, (l.elements))
-	return List{elements: newelements}
+	return newList(newelements)
 }
 
 func (l List) Length() int64 {
 	// really should panic
-	if len(l.elements) == 0 {
+	if l.listData == nil || len(l.elements) == 0 {
 		return 0
 	}
 	// element[0] is the head
@@ -47,7 +80,7 @@ func (l List) Length() int64 {
 }
 
 func (l List) String() string {
-	if len(l.elements) == 0 {
+	if l.listData == nil || len(l.elements) == 0 {
 		return "List()"
 	}
 
@@ -60,6 +93,11 @@ func (l List) String() string {
 			isListLiteral = true
 		}
 
+		// HoldForm(expr) prints as just expr
+		if l.Head() == symbol.HoldForm && len(l.elements) == 2 {
+			return l.elements[1].String()
+		}
+
 		if isListLiteral {
 			// This is a list literal: [element1, element2, ...]
 			var elements []string
@@ -78,7 +116,7 @@ func (l List) String() string {
 	return fmt.Sprintf("%s(%s)", l.Head().String(), strings.Join(elements[1:], ", "))
 }
 func (l List) InputForm() string {
-	return l.inputFormWithPrecedence(PrecedenceLowest)
+	return l.inputForm(PrecedenceLowest)
 }
 
 func (l List) Head() Expr {
@@ -88,6 +126,7 @@ func (l List) Head() Expr {
 // TODO DANGER
 func (l List) SetHead(name string) {
 	l.elements[0] = NewSymbol(name)
+	l.hash.Store(0)
 }
 
 func (l List) Tail() []Expr {
@@ -95,6 +134,9 @@ func (l List) Tail() []Expr {
 }
 
 func (l List) AsSlice() []Expr {
+	if l.listData == nil {
+		return nil
+	}
 	return l.elements
 }
 
@@ -111,6 +153,12 @@ func (l List) Equal(rhs Expr) bool {
 		return false
 	}
 
+	// Hashes are only compared once both are known, computing them would
+	// cost as much as the comparison
+	if lh, rh := l.cachedHash(), rhsList.cachedHash(); lh != 0 && rh != 0 && lh != rh {
+		return false
+	}
+
 	// Recursively compare each element
 	for i, elem := range lhsSlice {
 		if !elem.Equal(rhsSlice[i]) {
@@ -147,7 +195,7 @@ func (l List) Slice(start, stop int64) Expr {
 	newelements := make([]Expr, len(e)+1)
 	newelements[0] = l.Head()
 	copy(newelements[1:], e)
-	return List{elements: newelements}
+	return newList(newelements)
 }
 
 // Join joins this list with another sliceable of the same type
@@ -185,7 +233,7 @@ func (l List) Join(other Sliceable) Expr {
 	// Copy elements from second list (excluding head)
 	copy(newelements[1+l.Length():], otherList.Tail())
 
-	return List{elements: newelements}
+	return newList(newelements)
 }
 
 // Appends an expression to the end of a List
@@ -193,7 +241,35 @@ func (l List) Append(e Expr) List {
 	dest := make([]Expr, l.Length()+2)
 	copy(dest, l.AsSlice())
 	dest[len(dest)-1] = e
-	return List{elements: dest}
+	return newList(dest)
+}
+
+// Prepends an expression to the start of a List
+func (l List) Prepend(e Expr) List {
+	dest := make([]Expr, l.Length()+2)
+	dest[0] = l.Head()
+	dest[1] = e
+	copy(dest[2:], l.Tail())
+	return newList(dest)
+}
+
+// Insert returns a new List with e at position n (1-indexed), so that
+// Insert(1, e) prepends.  Negative n count from the end, so that
+// Insert(-1, e) appends.  Returns an error Expr if n is out of bounds.
+func (l List) Insert(n int64, e Expr) Expr {
+	length := l.Length()
+	if n < 0 {
+		n = length + n + 2
+	}
+	if n <= 0 || n > length+1 {
+		return NewError("PartError",
+			fmt.Sprintf("Insert position %d is out of bounds for list with %d elements", n, length))
+	}
+	dest := make([]Expr, length+2)
+	copy(dest, l.elements[:n])
+	dest[n] = e
+	copy(dest[n+1:], l.elements[n:])
+	return newList(dest)
 }
 
 // SetElementAt returns a new List with the nth element replaced (1-indexed)
@@ -223,7 +299,7 @@ func (l List) SetElementAt(n int64, value Expr) Expr {
 
 	//l.elements = newelements
 	//return value
-	return List{elements: newelements}
+	return newList(newelements)
 }
 
 // SetSlice returns a new List with elements from start to stop replaced by values (1-indexed)
@@ -310,7 +386,7 @@ func (l List) SetSlice(start, stop int64, values Expr) Expr {
 		copy(newelements[afterStart:], l.elements[stop+1:])
 	}
 
-	return List{elements: newelements}
+	return newList(newelements)
 }
 
 // insertValues is a helper method for inserting values at a specific position
@@ -343,5 +419,5 @@ func (l List) insertValues(pos int64, values Expr) Expr {
 		copy(newelements[pos+int64(len(valueSlice)):], l.Tail()[pos:])
 	}
 
-	return List{elements: newelements}
+	return newList(newelements)
 }
@@ -6,12 +6,113 @@ import (
 
 // Pure pattern matching (no variable binding)
 
-func IsExcept(pattern Expr) Expr {
+// IsExcept returns the excluded pattern and the pattern to match of
+// Except(c) or Except(c, p), or nil.  For Except(c) the pattern is Blank().
+func IsExcept(pattern Expr) (Expr, Expr) {
 	if pattern.Head() != symbol.Except {
-		return nil
+		return nil, nil
 	}
-	plist, _ := pattern.(List)
-	return plist.Tail()[0]
+	args := pattern.(List).Tail()
+	switch len(args) {
+	case 1:
+		return args[0], ListFrom(symbol.Blank)
+	case 2:
+		return args[0], args[1]
+	}
+	return nil, nil
+}
+
+// IsCondition returns the pattern and test of Condition(pattern, test), or nil
+func IsCondition(pattern Expr) (Expr, Expr) {
+	if pattern.Head() != symbol.Condition || pattern.Length() != 2 {
+		return nil, nil
+	}
+	args := pattern.(List).Tail()
+	return args[0], args[1]
+}
+
+// IsPatternTest returns the pattern and predicate of PatternTest(pattern, test), or nil
+func IsPatternTest(pattern Expr) (Expr, Expr) {
+	if pattern.Head() != symbol.PatternTest || pattern.Length() != 2 {
+		return nil, nil
+	}
+	args := pattern.(List).Tail()
+	return args[0], args[1]
+}
+
+// IsOptional returns the pattern and default of Optional(pattern, default), or nil.
+// The default of Optional(pattern), written x_., is nil; the definitions
+// of a function fill it in from Default before matching.
+func IsOptional(pattern Expr) (Expr, Expr) {
+	if pattern.Head() != symbol.Optional {
+		return nil, nil
+	}
+	args := pattern.(List).Tail()
+	switch len(args) {
+	case 1:
+		return args[0], nil
+	case 2:
+		return args[0], args[1]
+	}
+	return nil, nil
+}
+
+// IsRepeated returns the pattern and bounds of Repeated or RepeatedNull,
+// or nil.  A max of -1 means there is no upper bound.
+//
+//	Repeated(p)             1 or more
+//	Repeated(p, n)          1 to n
+//	Repeated(p, [n])        exactly n
+//	Repeated(p, [min, max]) min to max
+//
+// RepeatedNull is the same with a minimum of 0.
+func IsRepeated(pattern Expr) (Expr, int, int) {
+	head := pattern.Head()
+	if head != symbol.Repeated && head != symbol.RepeatedNull {
+		return nil, 0, 0
+	}
+	args := pattern.(List).Tail()
+	if len(args) == 0 || len(args) > 2 {
+		return nil, 0, 0
+	}
+	lo, hi := 1, -1
+	if head == symbol.RepeatedNull {
+		lo = 0
+	}
+	if len(args) == 1 {
+		return args[0], lo, hi
+	}
+	bound := func(e Expr) (int, bool) {
+		if e == symbol.Infinity {
+			return -1, true
+		}
+		n, ok := ExtractInt64(e)
+		return int(n), ok && n >= 0
+	}
+	spec := args[1]
+	if n, ok := bound(spec); ok {
+		return args[0], lo, n
+	}
+	if spec.Head() != symbol.List {
+		return nil, 0, 0
+	}
+	bounds := spec.(List).Tail()
+	switch len(bounds) {
+	case 1:
+		n, ok := bound(bounds[0])
+		if !ok || n < 0 {
+			return nil, 0, 0
+		}
+		return args[0], n, n
+	case 2:
+		lo, ok1 := bound(bounds[0])
+		hi, ok2 := bound(bounds[1])
+		if !ok1 || !ok2 || lo < 0 || (hi >= 0 && hi < lo) {
+			return nil, 0, 0
+		}
+		return args[0], lo, hi
+	}
+	return nil, 0, 0
 }
 
 func IsAlternatives(pattern Expr) []Expr {
@@ -25,13 +126,23 @@ func IsAlternatives(pattern Expr) []Expr {
 type Binding struct {
 	VarName string
 	Value   Expr
+
+	// Sequence is set when Value is the List of elements matched by a
+	// sequence pattern such as x__. They are spliced where the variable
+	// is used as an argument.
+	Sequence bool
 }
 
 // PatternBindings represents variable bindings from pattern matching
 type PatternBindings []Binding
 
 func (p *PatternBindings) Add(varname string, value Expr) {
-	*p = append(*p, Binding{varname, value})
+	*p = append(*p, Binding{VarName: varname, Value: value})
+}
+
+// AddSequence binds varname to the elements matched by a sequence pattern
+func (p *PatternBindings) AddSequence(varname string, elements []Expr) {
+	*p = append(*p, Binding{VarName: varname, Value: ListFrom(symbol.List, elements...), Sequence: true})
 }
 func (p *PatternBindings) Copy() *PatternBindings {
 	out := make(PatternBindings, len(*p))
@@ -40,14 +151,27 @@ func (p *PatternBindings) Copy() *PatternBindings {
 }
 
 func (p *PatternBindings) HasBinding(name string) Expr {
-	for _, b := range *p {
-		if b.VarName == name {
-			return b.Value
+	if b := p.find(name); b != nil {
+		return b.Value
+	}
+	return nil
+}
+
+// find returns the binding of name, or nil
+func (p *PatternBindings) find(name string) *Binding {
+	for i := range *p {
+		if (*p)[i].VarName == name {
+			return &(*p)[i]
 		}
 	}
 	return nil
 }
 
+// TestFunc evaluates an expression and reports whether the result is True.
+// It lets the matcher check Condition and PatternTest without depending on
+// the engine.
+type TestFunc func(Expr) bool
+
 // PatternMatcher provides pure pattern matching without side effects
 type PatternMatcher struct{}
 
@@ -58,37 +182,91 @@ func NewPatternMatcher() *PatternMatcher {
 
 // TestMatch tests if an expression matches a pattern (pure function, no binding)
 func (pm *PatternMatcher) TestMatch(expr, pattern Expr) bool {
-	return matchWithBindingsInternal(pattern, expr, nil)
+	return matchWithBindingsInternal(pattern, expr, nil, nil)
 }
 
 // MatchWithBindings performs pattern matching and captures variable bindings
 // Returns (matches, bindings)
 func MatchWithBindings(expr, pattern Expr) (bool, PatternBindings) {
+	return MatchWithTest(expr, pattern, nil)
+}
+
+// MatchWithTest is MatchWithBindings with support for Condition and PatternTest.
+// Condition tests are substituted with the current bindings and passed to
+// test, PatternTest passes pred(expr). If test is nil, neither ever matches.
+func MatchWithTest(expr, pattern Expr, test TestFunc) (bool, PatternBindings) {
 	bindings := make(PatternBindings, 0, 3)
-	matches := matchWithBindingsInternal(pattern, expr, &bindings)
+	matches := matchWithBindingsInternal(pattern, expr, &bindings, test)
 	return matches, bindings
 }
 
 // matchWithBindingsInternal implements pattern matching with binding capture
-func matchWithBindingsInternal(pattern, expr Expr, bindings *PatternBindings) bool {
+func matchWithBindingsInternal(pattern, expr Expr, bindings *PatternBindings, test TestFunc) bool {
 
 	if plist := IsAlternatives(pattern); plist != nil {
+		var mark int
+		if bindings != nil {
+			mark = len(*bindings)
+		}
 		for _, p := range plist {
-			if matchWithBindingsInternal(p, expr, bindings) {
+			if matchWithBindingsInternal(p, expr, bindings, test) {
 				return true
 			}
+			// discard partial bindings from the failed alternative
+			if bindings != nil {
+				*bindings = (*bindings)[:mark]
+			}
 		}
 		return false
 	}
 
+	if p, cond := IsCondition(pattern); p != nil {
+		return matchCondition(p, cond, expr, bindings, test)
+	}
+
+	if p, pred := IsPatternTest(pattern); p != nil {
+		return matchPatternTest(p, pred, expr, bindings, test)
+	}
+
+	// HoldPattern only keeps its pattern from being evaluated
+	if pattern.Head() == symbol.HoldPattern && pattern.Length() == 1 {
+		return matchWithBindingsInternal(pattern.(List).Tail()[0], expr, bindings, test)
+	}
+
+	// Verbatim matches its contents literally, Verbatim(x_) only matches x_
+	if pattern.Head() == symbol.Verbatim && pattern.Length() == 1 {
+		return sameExpr(pattern.(List).Tail()[0], expr)
+	}
+
+	if c, p := IsExcept(pattern); c != nil {
+		// variables in the excluded pattern are never bound
+		if matchWithBindingsInternal(c, expr, nil, test) {
+			return false
+		}
+		return matchWithBindingsInternal(p, expr, bindings, test)
+	}
+
+	// an Optional that is given a value matches like its pattern
+	if p, _ := IsOptional(pattern); p != nil {
+		return matchWithBindingsInternal(p, expr, bindings, test)
+	}
+
+	// outside of a sequence a repeated pattern matches a single element
+	if p, lo, hi := IsRepeated(pattern); p != nil {
+		return lo <= 1 && hi != 0 && matchWithBindingsInternal(p, expr, bindings, test)
+	}
+
 	if pinfo := GetSymbolicPatternInfo(pattern); pinfo.Type != PatternUnknown {
 		if !matchBlankWithBindings(pinfo, expr, bindings) {
 			return false
 		}
 		if vn := pinfo.VarName; vn != "" && bindings != nil {
-			if val := bindings.HasBinding(vn); val != nil {
+			if b := bindings.find(vn); b != nil {
 				// Variable already bound - check if values match
-				return val.Equal(expr)
+				if b.Sequence {
+					return bindSequence(vn, []Expr{expr}, bindings)
+				}
+				return b.Value.Equal(expr)
 			}
 			bindings.Add(vn, expr)
 		}
@@ -99,7 +277,7 @@ func matchWithBindingsInternal(pattern, expr Expr, bindings *PatternBindings) bo
 	switch p := pattern.(type) {
 	case List:
 		if exprList, ok := expr.(List); ok {
-			return matchListWithBindings(p, exprList, bindings)
+			return matchListWithBindings(p, exprList, bindings, test)
 		}
 		return false
 	default:
@@ -108,6 +286,60 @@ func matchWithBindingsInternal(pattern, expr Expr, bindings *PatternBindings) bo
 	}
 }
 
+// matchCondition matches the inner pattern and then checks the test with
+// the bindings made so far. Bindings are rolled back if the test fails.
+func matchCondition(pattern, cond, expr Expr, bindings *PatternBindings, test TestFunc) bool {
+	if test == nil {
+		return false
+	}
+	if bindings == nil {
+		local := make(PatternBindings, 0, 3)
+		bindings = &local
+	}
+	mark := len(*bindings)
+	if matchWithBindingsInternal(pattern, expr, bindings, test) && test(SubstituteBindings(cond, *bindings)) {
+		return true
+	}
+	*bindings = (*bindings)[:mark]
+	return false
+}
+
+// matchPatternTest matches the inner pattern and then checks pred(expr).
+// Bindings are rolled back if the predicate is not True.
+func matchPatternTest(pattern, pred, expr Expr, bindings *PatternBindings, test TestFunc) bool {
+	if test == nil {
+		return false
+	}
+	var mark int
+	if bindings != nil {
+		mark = len(*bindings)
+	}
+	if matchWithBindingsInternal(pattern, expr, bindings, test) && test(ListFrom(pred, expr)) {
+		return true
+	}
+	if bindings != nil {
+		*bindings = (*bindings)[:mark]
+	}
+	return false
+}
+
+// bindDefault binds the variable of an omitted Optional pattern to its
+// default. An Optional without a default can't be omitted.
+func bindDefault(pattern, def Expr, bindings *PatternBindings) bool {
+	if def == nil {
+		return false
+	}
+	vn := GetSymbolicPatternInfo(pattern).VarName
+	if vn == "" || bindings == nil {
+		return true
+	}
+	if val := bindings.HasBinding(vn); val != nil {
+		return val.Equal(def)
+	}
+	bindings.Add(vn, def)
+	return true
+}
+
 // matchBlankWithBindings tests if a blank pattern matches an expression
 func matchBlankWithBindings(pinfo PatternInfo, expr Expr, bindings *PatternBindings) bool {
 	if pinfo.Type == PatternUnknown {
@@ -115,19 +347,19 @@ func matchBlankWithBindings(pinfo PatternInfo, expr Expr, bindings *PatternBindi
 	}
 
 	// Check type constraint
-	return MatchesType(expr, pinfo.TypeName)
+	return pinfo.MatchesHead(expr)
 }
 
 // matchListWithBindings tests if a list pattern matches a list expression
-func matchListWithBindings(patternList, exprList List, bindings *PatternBindings) bool {
+func matchListWithBindings(patternList, exprList List, bindings *PatternBindings, test TestFunc) bool {
 	if patternList.Head() != exprList.Head() {
 		return false
 	}
-	return matchListWithBindingsSequential(patternList, exprList, bindings, 0, 0)
+	return matchListWithBindingsSequential(patternList, exprList, bindings, test, 0, 0)
 }
 
 // matchListWithBindingsSequential handles pattern matching with sequence patterns
-func matchListWithBindingsSequential(patternList, exprList List, bindings *PatternBindings, patternIdx, exprIdx int) bool {
+func matchListWithBindingsSequential(patternList, exprList List, bindings *PatternBindings, test TestFunc, patternIdx, exprIdx int) bool {
 
 	patternSlice := patternList.Tail()
 	exprSlice := exprList.Tail()
@@ -144,14 +376,27 @@ func matchListWithBindingsSequential(patternList, exprList List, bindings *Patte
 		for i := patternIdx; i < len(patternSlice); i++ {
 			elem := patternSlice[i]
 
+			if p, def := IsOptional(elem); p != nil {
+				if !bindDefault(p, def, bindings) {
+					return false
+				}
+				continue
+			}
+
+			if p, lo, _ := IsRepeated(elem); p != nil {
+				if lo != 0 {
+					return false
+				}
+				continue
+			}
+
 			pinfo := GetSymbolicPatternInfo(elem)
 			if pinfo.Type != BlankNullSequencePattern {
 				return false
 			}
-			if vn := pinfo.VarName; vn != "" && bindings != nil {
-				// Bind null sequence patterns to empty list
-				// Create a proper List with "List" as the first element (like the old system)
-				bindings.Add(vn, ListFrom(symbol.List))
+			// null sequence patterns are bound to no elements
+			if !bindSequence(pinfo.VarName, nil, bindings) {
+				return false
 			}
 		}
 		return true
@@ -161,29 +406,53 @@ func matchListWithBindingsSequential(patternList, exprList List, bindings *Patte
 	pinfo := GetSymbolicPatternInfo(patternElem)
 	if pinfo.Type == BlankNullSequencePattern || pinfo.Type == BlankSequencePattern {
 		// Check if this is a sequence pattern
-		return matchSequencePatternWithBindings(patternList, exprList, bindings, patternIdx, exprIdx, pinfo)
+		return matchSequencePatternWithBindings(patternList, exprList, bindings, test, patternIdx, exprIdx, pinfo)
+	}
+
+	// Optional pattern - match one element, or skip it and use the default
+	if p, def := IsOptional(patternElem); p != nil {
+		var mark int
+		if bindings != nil {
+			mark = len(*bindings)
+		}
+		if matchWithBindingsInternal(p, exprSlice[exprIdx], bindings, test) &&
+			matchListWithBindingsSequential(patternList, exprList, bindings, test, patternIdx+1, exprIdx+1) {
+			return true
+		}
+		if bindings != nil {
+			*bindings = (*bindings)[:mark]
+		}
+		return bindDefault(p, def, bindings) &&
+			matchListWithBindingsSequential(patternList, exprList, bindings, test, patternIdx+1, exprIdx)
+	}
+
+	// Repeated pattern - match as many elements as possible
+	if p, lo, hi := IsRepeated(patternElem); p != nil {
+		return matchRepeatedWithBindings(patternList, exprList, bindings, test, patternIdx, exprIdx, p, lo, hi)
 	}
 
 	// Regular pattern - match one element
-	if matchWithBindingsInternal(patternElem, exprSlice[exprIdx], bindings) {
-		return matchListWithBindingsSequential(patternList, exprList, bindings, patternIdx+1, exprIdx+1)
+	if matchWithBindingsInternal(patternElem, exprSlice[exprIdx], bindings, test) {
+		return matchListWithBindingsSequential(patternList, exprList, bindings, test, patternIdx+1, exprIdx+1)
 	}
 
 	return false
 }
 
 // matchSequencePatternWithBindings handles matching sequence patterns
-func matchSequencePatternWithBindings(patternList, exprList List, bindings *PatternBindings, patternIdx, exprIdx int, pinfo PatternInfo) bool { //
+func matchSequencePatternWithBindings(patternList, exprList List, bindings *PatternBindings, test TestFunc, patternIdx, exprIdx int, pinfo PatternInfo) bool { //
 	// , varName, typeName string, allowZero bool) bool {
 
-	typeName := pinfo.TypeName
 	varName := pinfo.VarName
 	allowZero := pinfo.Type == BlankNullSequencePattern
 
 	patternSlice := patternList.Tail()
 	exprSlice := exprList.Tail()
 
-	remainingPatterns := len(patternSlice) - patternIdx - 1
+	remainingPatterns := 0
+	for _, p := range patternSlice[patternIdx+1:] {
+		remainingPatterns += minElements(p)
+	}
 	remainingExprs := len(exprSlice) - exprIdx
 
 	// Minimum elements this sequence must consume
@@ -199,41 +468,111 @@ func matchSequencePatternWithBindings(patternList, exprList List, bindings *Patt
 		return false
 	}
 
-	// Try consuming different numbers of elements (greedy approach)
-	for consume := maxConsume; consume >= minConsume; consume-- {
+	// Only a run of elements of the right type can be consumed, so find
+	// it once instead of checking every candidate length
+	if pinfo.TypeName != "" || pinfo.Head != nil {
+		run := 0
+		for run < maxConsume && pinfo.MatchesHead(exprSlice[exprIdx+run]) {
+			run++
+		}
+		maxConsume = run
+	}
 
-		// Collect the elements to bind to this sequence
-		var seqElements []Expr
-		for i := 0; i < consume; i++ {
-			if exprIdx+i < len(exprSlice) {
-				seqElements = append(seqElements, exprSlice[exprIdx+i])
-			}
+	var mark int
+	if bindings != nil {
+		mark = len(*bindings)
+	}
+
+	// Try consuming different numbers of elements (greedy approach). The
+	// sequence is bound first, so the rest of the pattern and its
+	// conditions see it.
+	for consume := maxConsume; consume >= minConsume; consume-- {
+		if bindSequence(varName, exprSlice[exprIdx:exprIdx+consume], bindings) &&
+			matchListWithBindingsSequential(patternList, exprList, bindings, test, patternIdx+1, exprIdx+consume) {
+			return true
+		}
+		if bindings != nil {
+			*bindings = (*bindings)[:mark]
 		}
+	}
 
-		// Check type constraints for sequence elements
-		if typeName != "" {
-			allMatch := true
-			for _, elem := range seqElements {
-				if !MatchesType(elem, typeName) {
-					allMatch = false
-					break
-				}
-			}
-			if !allMatch {
-				continue // Try with fewer elements
+	return false
+}
+
+// bindSequence binds the variable of a sequence pattern to elements, or
+// checks them against the elements it is already bound to
+func bindSequence(varName string, elements []Expr, bindings *PatternBindings) bool {
+	if varName == "" || bindings == nil {
+		return true
+	}
+	if b := bindings.find(varName); b != nil {
+		if !b.Sequence {
+			return len(elements) == 1 && b.Value.Equal(elements[0])
+		}
+		bound := b.Value.(List).Tail()
+		if len(bound) != len(elements) {
+			return false
+		}
+		for i := range bound {
+			if !bound[i].Equal(elements[i]) {
+				return false
 			}
 		}
+		return true
+	}
+	bindings.AddSequence(varName, elements)
+	return true
+}
 
-		// Try to match remaining patterns
-		if matchListWithBindingsSequential(patternList, exprList, bindings, patternIdx+1, exprIdx+consume) {
-			// Success - copy bindings back
-			//*bindings = *testBindings
-			if varName != "" && bindings != nil {
-				bindings.Add(varName, ListFrom(symbol.List, seqElements...))
+// matchRepeatedWithBindings matches between lo and hi elements (hi of -1 is
+// unbounded) that each match pattern, trying the longest run first
+func matchRepeatedWithBindings(patternList, exprList List, bindings *PatternBindings, test TestFunc, patternIdx, exprIdx int, pattern Expr, lo, hi int) bool {
+	patternSlice := patternList.Tail()
+	exprSlice := exprList.Tail()
+
+	remainingPatterns := 0
+	for _, p := range patternSlice[patternIdx+1:] {
+		remainingPatterns += minElements(p)
+	}
+	maxConsume := len(exprSlice) - exprIdx - remainingPatterns
+	if hi >= 0 && hi < maxConsume {
+		maxConsume = hi
+	}
+
+	var mark int
+	if bindings != nil {
+		mark = len(*bindings)
+	}
+
+	// find the longest run of matching elements, then back off
+	run := 0
+	for run < maxConsume && matchWithBindingsInternal(pattern, exprSlice[exprIdx+run], bindings, test) {
+		run++
+	}
+	for consume := run; consume >= lo; consume-- {
+		if bindings != nil {
+			*bindings = (*bindings)[:mark]
+			for i := 0; i < consume; i++ {
+				matchWithBindingsInternal(pattern, exprSlice[exprIdx+i], bindings, test)
 			}
+		}
+		if matchListWithBindingsSequential(patternList, exprList, bindings, test, patternIdx+1, exprIdx+consume) {
 			return true
 		}
 	}
-
+	if bindings != nil {
+		*bindings = (*bindings)[:mark]
+	}
 	return false
 }
+
+// minElements returns the fewest elements a pattern in a sequence can match
+func minElements(pattern Expr) int {
+	if _, def := IsOptional(pattern); def != nil {
+		return 0
+	}
+	if p, lo, _ := IsRepeated(pattern); p != nil {
+		return lo
+	}
+	return 1
+}
@@ -20,3 +20,48 @@ func BenchmarkSREOLDMatchWithBindings(b *testing.B) {
 		MatchWithBindings(e, m)
 	}
 }
+
+// longList returns f(1, 2, ..., n) followed by the extra elements
+func longList(n int, extra ...Expr) Expr {
+	args := make([]Expr, 0, n+len(extra))
+	for i := 1; i <= n; i++ {
+		args = append(args, NewInteger(int64(i)))
+	}
+	return ListFrom(NewSymbol("f"), append(args, extra...)...)
+}
+
+func TestMatchWithBindings_LongSequences(t *testing.T) {
+	const n = 10000
+	tests := []struct {
+		name    string
+		expr    Expr
+		pattern string
+		match   bool
+		lengths map[string]int // sequence variable -> elements bound
+	}{
+		{"trailing null sequence", longList(n), "f(a_, x___)", true, map[string]int{"x": n - 1}},
+		{"typed sequence then rest", longList(n, NewString("end")), "f(x__Integer, y___)", true, map[string]int{"x": n, "y": 1}},
+		{"leading sequence, literal last", longList(n), "f(x___, 10000)", true, map[string]int{"x": n - 1}},
+		{"greedy first sequence", longList(n), "f(x__, y__)", true, map[string]int{"x": n - 1, "y": 1}},
+		{"typed sequence cannot reach the end", longList(n, NewString("end"), NewInteger(1)), "f(x__Integer, \"end\")", false, nil},
+		{"null sequence then repeated", longList(n), "f(x___, (_Integer)..)", true, map[string]int{"x": n - 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, bindings := MatchWithBindings(tt.expr, MustParse(tt.pattern))
+			if ok != tt.match {
+				t.Fatalf("match = %v, want %v", ok, tt.match)
+			}
+			for name, want := range tt.lengths {
+				value := bindings.HasBinding(name)
+				if value == nil {
+					t.Errorf("%s is not bound", name)
+					continue
+				}
+				if got := int(value.Length()); got != want {
+					t.Errorf("%s bound to %d elements, want %d", name, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package core
+
+import (
+	"sync"
+
+	"github.com/client9/cardinal/core/symbol"
+)
+
+// Matcher is a pattern prepared once for matching many times, such as
+// the left side of a definition. Patterns with one plain element per
+// argument, like fib(n_Integer) or fib(0), are analysed by CompilePattern
+// and matched directly. Any other pattern is matched by MatchWithTest.
+type Matcher struct {
+	pattern Expr
+	head    Expr
+	args    []argMatcher // nil if the pattern is not simple
+	named   int          // number of named arguments, to size the bindings
+}
+
+// argMatcher matches one argument of a simple pattern
+type argMatcher struct {
+	literal Expr        // an atom that must be equal, or nil for a Blank
+	info    PatternInfo // the Blank, with its name and head if any
+}
+
+// matcherCache keeps the Matchers made by CompilePattern, found by the
+// Hash of the pattern. A Matcher is not changed after it is made, so one
+// can be shared. The cache is emptied when it reaches matcherCacheSize.
+var matcherCache = struct {
+	sync.Mutex
+	buckets map[uint64][]*Matcher
+	size    int
+}{buckets: make(map[uint64][]*Matcher)}
+
+const matcherCacheSize = 4096
+
+// CompilePattern analyses pattern for use with Match. The same Matcher is
+// returned for patterns with the same structure.
+func CompilePattern(pattern Expr) *Matcher {
+	h := Hash(pattern)
+	matcherCache.Lock()
+	for _, m := range matcherCache.buckets[h] {
+		if samePattern(m.pattern, pattern) {
+			matcherCache.Unlock()
+			return m
+		}
+	}
+	matcherCache.Unlock()
+
+	m := compilePattern(pattern)
+
+	matcherCache.Lock()
+	if matcherCache.size >= matcherCacheSize {
+		clear(matcherCache.buckets)
+		matcherCache.size = 0
+	}
+	matcherCache.buckets[h] = append(matcherCache.buckets[h], m)
+	matcherCache.size++
+	matcherCache.Unlock()
+	return m
+}
+
+// samePattern is sameExpr, quick when a pattern is looked up again with
+// the very same List
+func samePattern(a, b Expr) bool {
+	if al, ok := a.(List); ok {
+		if bl, ok := b.(List); ok && al.listData == bl.listData {
+			return true
+		}
+	}
+	return sameExpr(a, b)
+}
+
+// compilePattern makes a new Matcher, see CompilePattern
+func compilePattern(pattern Expr) *Matcher {
+	m := &Matcher{pattern: pattern}
+	list, ok := pattern.(List)
+	if !ok {
+		return m
+	}
+	switch list.Head() {
+	case symbol.Pattern, symbol.Blank, symbol.BlankSequence, symbol.BlankNullSequence,
+		symbol.Alternatives, symbol.Condition, symbol.PatternTest, symbol.HoldPattern,
+		symbol.Except, symbol.Optional, symbol.Repeated, symbol.RepeatedNull, symbol.Verbatim:
+		// the pattern as a whole has a meaning
+		return m
+	}
+	if _, ok := list.Head().(Symbol); !ok {
+		return m
+	}
+	args := make([]argMatcher, 0, list.Length())
+	for _, arg := range list.Tail() {
+		if _, ok := arg.(List); !ok {
+			args = append(args, argMatcher{literal: arg})
+			continue
+		}
+		info, ok := simpleBlank(arg)
+		if !ok {
+			return m
+		}
+		if info.VarName != "" {
+			m.named++
+		}
+		args = append(args, argMatcher{info: info})
+	}
+	m.head = list.Head()
+	m.args = args
+	return m
+}
+
+// simpleBlank returns the PatternInfo of _, _h, x_ or x_h
+func simpleBlank(e Expr) (PatternInfo, bool) {
+	blank := e
+	if ok, name, b := IsSymbolicPattern(e); ok {
+		if _, ok := name.(Symbol); !ok {
+			return PatternInfo{}, false
+		}
+		blank = b
+	}
+	l, ok := blank.(List)
+	if !ok || l.Head() != symbol.Blank || l.Length() > 1 {
+		return PatternInfo{}, false
+	}
+	if l.Length() == 1 {
+		if _, ok := l.Tail()[0].(Symbol); !ok {
+			return PatternInfo{}, false
+		}
+	}
+	return GetSymbolicPatternInfo(e), true
+}
+
+// Pattern returns the pattern the Matcher was compiled from
+func (m *Matcher) Pattern() Expr {
+	return m.pattern
+}
+
+// Match is MatchWithTest(expr, m.Pattern(), test)
+func (m *Matcher) Match(expr Expr, test TestFunc) (bool, PatternBindings) {
+	if m.args == nil {
+		return MatchWithTest(expr, m.pattern, test)
+	}
+	list, ok := expr.(List)
+	if !ok || list.Head() != m.head || list.Length() != int64(len(m.args)) {
+		return false, nil
+	}
+	var bindings PatternBindings
+	if m.named > 0 {
+		bindings = make(PatternBindings, 0, m.named)
+	}
+	for i, arg := range list.Tail() {
+		am := &m.args[i]
+		if am.literal != nil {
+			if !am.literal.Equal(arg) {
+				return false, nil
+			}
+			continue
+		}
+		if !MatchesType(arg, am.info.TypeName) {
+			return false, nil
+		}
+		if vn := am.info.VarName; vn != "" {
+			if val := bindings.HasBinding(vn); val != nil {
+				if !val.Equal(arg) {
+					return false, nil
+				}
+				continue
+			}
+			bindings.Add(vn, arg)
+		}
+	}
+	return true, bindings
+}
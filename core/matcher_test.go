@@ -0,0 +1,169 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestMatcher_SameAsMatchWithTest(t *testing.T) {
+	patterns := []string{
+		"fib(0)",
+		"fib(n_Integer)",
+		"f(x_, y_)",
+		"f(x_, x_)",
+		"f(_, \"a\", _Real)",
+		"f(n_Number)",
+		"f()",
+		"f(x__)",
+		"f(x_, y___)",
+		"f(x_ | y_String)",
+		"f(g(x_))",
+		"f(x_Integer?EvenQ)",
+		"f(Optional(x_, 1))",
+	}
+	exprs := []string{
+		"fib(0)", "fib(1)", "fib(2.0)", "fib(0, 1)", "fib", "g(0)",
+		"f(1, 2)", "f(1, 1)", "f(a, \"a\", 1.5)", "f(a, \"b\", 1.5)", "f(2.5)",
+		"f()", "f(1, 2, 3)", "f(g(1))", "f(\"s\")",
+	}
+	for _, ps := range patterns {
+		pattern := MustParse(ps)
+		m := CompilePattern(pattern)
+		for _, es := range exprs {
+			expr := MustParse(es)
+			want, wantBindings := MatchWithTest(expr, pattern, nil)
+			got, gotBindings := m.Match(expr, nil)
+			if got != want {
+				t.Errorf("%s against %s: Match = %v, MatchWithTest = %v", es, ps, got, want)
+				continue
+			}
+			if !got {
+				continue
+			}
+			if len(gotBindings) != len(wantBindings) {
+				t.Errorf("%s against %s: bindings %v, want %v", es, ps, gotBindings, wantBindings)
+				continue
+			}
+			for i := range gotBindings {
+				if gotBindings[i].VarName != wantBindings[i].VarName || !gotBindings[i].Value.Equal(wantBindings[i].Value) {
+					t.Errorf("%s against %s: bindings %v, want %v", es, ps, gotBindings, wantBindings)
+				}
+			}
+		}
+	}
+}
+
+func TestCompilePattern_Simple(t *testing.T) {
+	tests := []struct {
+		pattern string
+		simple  bool
+	}{
+		{"fib(0)", true},
+		{"fib(n_Integer)", true},
+		{"f(x_, _, \"s\")", true},
+		{"f()", true},
+		{"f(x__)", false},
+		{"f(g(x_))", false},
+		{"f(x_ | y_)", false},
+		{"f(x_?EvenQ)", false},
+		{"f(x_)(y_)", false},
+		{"x_", false},
+		{"HoldPattern(f(x_))", false},
+	}
+	for _, tt := range tests {
+		m := CompilePattern(MustParse(tt.pattern))
+		if simple := m.args != nil; simple != tt.simple {
+			t.Errorf("CompilePattern(%s): simple = %v, want %v", tt.pattern, simple, tt.simple)
+		}
+	}
+}
+
+// Benchmarks matching the definitions of fib, in the order they are
+// tried, against a call. Compare allocations with -benchmem.
+func BenchmarkMatchWithTestFib(b *testing.B) {
+	patterns := []Expr{MustParse("fib(0)"), MustParse("fib(1)"), MustParse("fib(n_Integer)")}
+	expr := MustParse("fib(20)")
+	for b.Loop() {
+		for _, p := range patterns {
+			MatchWithTest(expr, p, nil)
+		}
+	}
+}
+
+func BenchmarkMatcherFib(b *testing.B) {
+	matchers := []*Matcher{
+		CompilePattern(MustParse("fib(0)")),
+		CompilePattern(MustParse("fib(1)")),
+		CompilePattern(MustParse("fib(n_Integer)")),
+	}
+	expr := MustParse("fib(20)")
+	for b.Loop() {
+		for _, m := range matchers {
+			m.Match(expr, nil)
+		}
+	}
+}
+
+func TestCompilePattern_Cache(t *testing.T) {
+	a := CompilePattern(MustParse("cached(x_Integer, \"s\")"))
+	b := CompilePattern(MustParse("cached(x_Integer, \"s\")"))
+	if a != b {
+		t.Error("patterns with the same structure should share a Matcher")
+	}
+	if !a.Pattern().Equal(MustParse("cached(x_Integer, \"s\")")) {
+		t.Errorf("cached Matcher has pattern %s", a.Pattern())
+	}
+
+	for _, other := range []string{"cached(x_Real, \"s\")", "cached(y_Integer, \"s\")", "cached(x_Integer, s)"} {
+		if CompilePattern(MustParse(other)) == a {
+			t.Errorf("%s should not share the Matcher of %s", other, a.Pattern())
+		}
+	}
+
+	// 1.0 is Equal to 1, but they are different patterns
+	if CompilePattern(MustParse("cached(1)")) == CompilePattern(MustParse("cached(1.0)")) {
+		t.Error("cached(1) and cached(1.0) should not share a Matcher")
+	}
+}
+
+func TestCompilePattern_CacheLimit(t *testing.T) {
+	first := CompilePattern(MustParse("limit(0)"))
+	for i := 1; i <= matcherCacheSize; i++ {
+		CompilePattern(ListFrom(NewSymbol("limit"), NewInteger(int64(i))))
+	}
+	matcherCache.Lock()
+	size := matcherCache.size
+	matcherCache.Unlock()
+	if size > matcherCacheSize {
+		t.Errorf("cache holds %d matchers, limit %d", size, matcherCacheSize)
+	}
+	// a Matcher made again after the cache was emptied still works
+	again := CompilePattern(MustParse("limit(0)"))
+	if ok, _ := again.Match(MustParse("limit(0)"), nil); !ok {
+		t.Error("limit(0) should match after the cache was emptied")
+	}
+	if ok, _ := first.Match(MustParse("limit(0)"), nil); !ok {
+		t.Error("a Matcher dropped from the cache should keep working")
+	}
+}
+
+// Benchmarks matching a list against the same pattern, as Cases does,
+// making a new Matcher each time and reusing the cached one
+func BenchmarkCompilePatternUncached(b *testing.B) {
+	exprs := MustParse("[f(1, a), f(2, b), g(3), f(x, c)]").(List).Tail()
+	pattern := MustParse("f(n_Integer, s_Symbol)")
+	for b.Loop() {
+		for _, e := range exprs {
+			compilePattern(pattern).Match(e, nil)
+		}
+	}
+}
+
+func BenchmarkCompilePatternCached(b *testing.B) {
+	exprs := MustParse("[f(1, a), f(2, b), g(3), f(x, c)]").(List).Tail()
+	pattern := MustParse("f(n_Integer, s_Symbol)")
+	for b.Loop() {
+		for _, e := range exprs {
+			CompilePattern(pattern).Match(e, nil)
+		}
+	}
+}
@@ -150,16 +150,27 @@ func DivReal(xi, yi Real) Real {
 	return new(big.Float).Quo(x, y)
 }
 
-func PowerInteger(xi, yi Integer) Integer {
+// PowerInteger returns xi^yi for yi >= 0.  An exponent too large for an
+// int64 is only exact for the bases 0, 1 and -1, any other base returns
+// an error.
+func PowerInteger(xi, yi Integer) (Integer, error) {
 	if !yi.IsInt64() {
-		panic("overflow")
+		switch {
+		case xi.Sign() == 0, xi.IsInt64() && xi.Int64() == 1:
+			return xi, nil
+		case xi.IsInt64() && xi.Int64() == -1:
+			if yi.AsBigInt().Bit(0) == 0 {
+				return NewInteger(1), nil
+			}
+			return xi, nil
+		}
+		return nil, fmt.Errorf("exponent %s is too large", yi)
 	}
 	y := yi.Int64()
 	if xi.IsInt64() {
-		return powerSmall(xi.Int64(), y)
+		return powerSmall(xi.Int64(), y), nil
 	}
-	return powerBig(xi.AsBigInt(), y)
-
+	return powerBig(xi.AsBigInt(), y), nil
 }
 
 func PowerFloat64(base, exp float64) (float64, error) {
@@ -0,0 +1,145 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+)
+
+// InfixOperator describes a user-defined infix operator
+type InfixOperator struct {
+	Op         string     // Spelling, e.g. "~"
+	Head       Expr       // Head of the resulting expression: a ~ b -> Head(a, b)
+	Precedence Precedence // Binding strength, compared with the builtin Precedence* levels
+	RightAssoc bool       // a ~ b ~ c is a ~ (b ~ c) instead of (a ~ b) ~ c
+}
+
+// customOperatorTokens are the operator spellings reserved for user
+// definitions.  They are lexed as tokens but have no builtin meaning.
+var customOperatorTokens = map[string]TokenType{
+	"~":  TILDE,
+	"**": STARSTAR,
+	"<>": DIAMOND,
+}
+
+// builtinOperatorTokens maps the spelling of builtin infix operators to
+// their token, for precedence queries.
+var builtinOperatorTokens = map[string]TokenType{
+	";":   SEMICOLON,
+	"=":   SET,
+	":=":  SETDELAYED,
+	"=.":  UNSET,
+	"^=":  UPSET,
+	"^:=": UPSETDELAYED,
+	"/.":  REPLACEALL,
+	":":   COLON,
+	"=>":  RULEDELAYED,
+	"/;":  CONDITION,
+	"|":   PIPE,
+	"&":   AMPERSAND,
+	"||":  OR,
+	"&&":  AND,
+	"==":  EQUAL,
+	"!=":  UNEQUAL,
+	"===": SAMEQ,
+	"=!=": UNSAMEQ,
+	"<":   LESS,
+	">":   GREATER,
+	"<=":  LESSEQUAL,
+	">=":  GREATEREQUAL,
+	"+":   PLUS,
+	"-":   MINUS,
+	"*":   MULTIPLY,
+	"/":   DIVIDE,
+	"^":   CARET,
+	"?":   QUESTION,
+}
+
+// IsCustomOperatorToken reports whether a token is reserved for user operators
+func IsCustomOperatorToken(tokenType TokenType) bool {
+	switch tokenType {
+	case TILDE, STARSTAR, DIAMOND:
+		return true
+	default:
+		return false
+	}
+}
+
+// CustomOperators returns the operator spellings available for Define, sorted
+func CustomOperators() []string {
+	ops := make([]string, 0, len(customOperatorTokens))
+	for op := range customOperatorTokens {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+	return ops
+}
+
+// OperatorTable holds user-defined infix operators for a parser
+type OperatorTable struct {
+	operators map[TokenType]InfixOperator
+}
+
+// NewOperatorTable creates an empty operator table
+func NewOperatorTable() *OperatorTable {
+	return &OperatorTable{
+		operators: make(map[TokenType]InfixOperator),
+	}
+}
+
+// Define registers (or redefines) a custom infix operator.
+// Only spellings from CustomOperators may be used.
+func (t *OperatorTable) Define(op string, head Expr, prec Precedence, rightAssoc bool) error {
+	tokenType, ok := customOperatorTokens[op]
+	if !ok {
+		return fmt.Errorf("operator %q is not available, use one of %v", op, CustomOperators())
+	}
+	if prec <= PrecedenceLowest || prec >= PrecedencePostfix {
+		return fmt.Errorf("precedence %d out of range, must be between %d and %d", prec, PrecedenceLowest, PrecedencePostfix)
+	}
+	t.operators[tokenType] = InfixOperator{
+		Op:         op,
+		Head:       head,
+		Precedence: prec,
+		RightAssoc: rightAssoc,
+	}
+	return nil
+}
+
+// Lookup returns the definition for a custom operator token
+func (t *OperatorTable) Lookup(tokenType TokenType) (InfixOperator, bool) {
+	if t == nil {
+		return InfixOperator{}, false
+	}
+	op, ok := t.operators[tokenType]
+	return op, ok
+}
+
+// Precedence returns the precedence of a builtin or user-defined operator
+func (t *OperatorTable) Precedence(op string) (Precedence, bool) {
+	if tokenType, ok := builtinOperatorTokens[op]; ok {
+		return precedences[tokenType], true
+	}
+	if tokenType, ok := customOperatorTokens[op]; ok {
+		if def, ok := t.Lookup(tokenType); ok {
+			return def.Precedence, true
+		}
+	}
+	return 0, false
+}
+
+// ParseStringWithOperators parses input, recognizing user-defined operators
+func ParseStringWithOperators(input string, operators *OperatorTable) (Expr, error) {
+	lexer := NewLexer(input)
+	parser := NewParser(lexer)
+	parser.operators = operators
+	return parser.Parse()
+}
+
+// ParseAllWithOperators parses every expression in input, recognizing
+// user-defined operators
+func ParseAllWithOperators(input string, operators *OperatorTable) ([]Expr, error) {
+	lexer := NewLexer(input)
+	parser := NewParser(lexer)
+	parser.operators = operators
+	return parser.ParseAll()
+}
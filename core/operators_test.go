@@ -0,0 +1,80 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestOperatorTable_Parse(t *testing.T) {
+	ops := NewOperatorTable()
+	if err := ops.Define("~", NewSymbol("f"), PrecedenceSum+5, false); err != nil {
+		t.Fatalf("Define: %v", err)
+	}
+	if err := ops.Define("**", NewSymbol("g"), PrecedencePower, true); err != nil {
+		t.Fatalf("Define: %v", err)
+	}
+	if err := ops.Define("<>", NewSymbol("StringJoin"), PrecedenceSum, false); err != nil {
+		t.Fatalf("Define: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"simple", "a ~ b", "f(a, b)"},
+		{"left associative", "a ~ b ~ c", "f(f(a, b), c)"},
+		{"right associative", "a ** b ** c", "g(a, g(b, c))"},
+		{"binds tighter than plus", "a + b ~ c", "Plus(a, f(b, c))"},
+		{"binds looser than times", "a ~ b * c", "f(a, Times(b, c))"},
+		{"same level as plus", "\"a\" <> \"b\" + 1", "Plus(StringJoin(\"a\", \"b\"), 1)"},
+		{"inside call", "h(a ~ b, c)", "h(f(a, b), c)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := ParseStringWithOperators(tt.input, ops)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if expr.String() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, expr.String())
+			}
+		})
+	}
+}
+
+func TestOperatorTable_Errors(t *testing.T) {
+	ops := NewOperatorTable()
+	if err := ops.Define("+", NewSymbol("f"), PrecedenceSum, false); err == nil {
+		t.Error("expected error redefining a builtin operator")
+	}
+	if err := ops.Define("~", NewSymbol("f"), PrecedencePostfix, false); err == nil {
+		t.Error("expected error for out of range precedence")
+	}
+
+	// undefined operators are parse errors, with or without a table
+	for _, input := range []string{"a ~ b", "f(a ** b)", "a <> b"} {
+		if _, err := ParseString(input); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+		if _, err := ParseStringWithOperators(input, ops); err == nil {
+			t.Errorf("expected error for %q with empty table", input)
+		}
+	}
+}
+
+func TestOperatorTable_Precedence(t *testing.T) {
+	ops := NewOperatorTable()
+	if prec, ok := ops.Precedence("+"); !ok || prec != PrecedenceSum {
+		t.Errorf("expected + to have PrecedenceSum, got %d %v", prec, ok)
+	}
+	if _, ok := ops.Precedence("~"); ok {
+		t.Error("expected ~ to be undefined")
+	}
+	if err := ops.Define("~", NewSymbol("f"), 95, false); err != nil {
+		t.Fatalf("Define: %v", err)
+	}
+	if prec, ok := ops.Precedence("~"); !ok || prec != 95 {
+		t.Errorf("expected ~ to have precedence 95, got %d %v", prec, ok)
+	}
+}
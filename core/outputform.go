@@ -0,0 +1,295 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/client9/cardinal/core/symbol"
+)
+
+// outputOperator describes how a head is printed as an infix operator
+type outputOperator struct {
+	op         string     // separator placed between the arguments
+	precedence Precedence // binding strength, the same levels the parser uses
+	rightAssoc bool       // a ^ b ^ c groups as a ^ (b ^ c)
+	nary       bool       // more than two arguments are joined: a && b && c
+}
+
+// outputOperators lists the heads OutputForm prints infix.
+// Plus and Times have their own rules, see outputSum and outputProduct.
+var outputOperators = map[Expr]outputOperator{
+	symbol.CompoundExpression: {op: "; ", precedence: PrecedenceCompound, nary: true},
+	symbol.Set:                {op: " = ", precedence: PrecedenceAssign, rightAssoc: true},
+	symbol.SetDelayed:         {op: " := ", precedence: PrecedenceAssign, rightAssoc: true},
+	symbol.UpSet:              {op: " ^= ", precedence: PrecedenceAssign, rightAssoc: true},
+	symbol.UpSetDelayed:       {op: " ^:= ", precedence: PrecedenceAssign, rightAssoc: true},
+	symbol.ReplaceAll:         {op: " /. ", precedence: PrecedenceReplace},
+	symbol.Rule:               {op: ": ", precedence: PrecedenceRule},
+	symbol.RuleDelayed:        {op: " => ", precedence: PrecedenceRule},
+	symbol.Condition:          {op: " /; ", precedence: PrecedenceCondition},
+	symbol.Alternatives:       {op: " | ", precedence: PrecedenceAlternatives, nary: true},
+	symbol.Or:                 {op: " || ", precedence: PrecedenceLogicalOr, nary: true},
+	symbol.And:                {op: " && ", precedence: PrecedenceLogicalAnd, nary: true},
+	symbol.Equal:              {op: " == ", precedence: PrecedenceEquality},
+	symbol.Unequal:            {op: " != ", precedence: PrecedenceEquality},
+	symbol.SameQ:              {op: " === ", precedence: PrecedenceEquality},
+	symbol.UnsameQ:            {op: " =!= ", precedence: PrecedenceEquality},
+	symbol.Less:               {op: " < ", precedence: PrecedenceComparison, nary: true},
+	symbol.Greater:            {op: " > ", precedence: PrecedenceComparison, nary: true},
+	symbol.LessEqual:          {op: " <= ", precedence: PrecedenceComparison, nary: true},
+	symbol.GreaterEqual:       {op: " >= ", precedence: PrecedenceComparison, nary: true},
+	symbol.Subtract:           {op: " - ", precedence: PrecedenceSum},
+	symbol.Divide:             {op: " / ", precedence: PrecedenceDivide},
+	symbol.Power:              {op: "^", precedence: PrecedencePower, rightAssoc: true},
+}
+
+// OutputForm returns an expression the way the REPL shows it: operators
+// are infix with only the parentheses precedence needs, lists use [...]
+// and associations {k: v}.
+//
+// Unlike InputForm it is meant for reading, not for parsing back: strings
+// are not quoted, HoldForm is dropped, a + -1 * b prints as a - b and
+// x * y^-1 as x / y.
+func OutputForm(e Expr) string {
+	return outputForm(e, PrecedenceLowest)
+}
+
+// outputForm prints e inside an operator of the given precedence, adding
+// parentheses if e binds more loosely
+func outputForm(e Expr, parent Precedence) string {
+	switch ex := e.(type) {
+	case String:
+		return string(ex)
+	case Number:
+		// -2 and 1/2 are operators in disguise: (-2)^2, (1/2)^2
+		s := ex.String()
+		if ex.Sign() < 0 {
+			return parenthesize(s, PrecedenceSum, parent)
+		}
+		if strings.Contains(s, "/") {
+			return parenthesize(s, PrecedenceDivide, parent)
+		}
+		return s
+	case Association:
+		var parts []string
+		for _, key := range ex.Keys() {
+			value, _ := ex.Get(key)
+			parts = append(parts, outputForm(key, PrecedenceRule+1)+": "+outputForm(value, PrecedenceRule))
+		}
+		return "{" + strings.Join(parts, ", ") + "}"
+	case FunctionExpr:
+		var params []string
+		for _, p := range ex.Parameters {
+			params = append(params, p.String())
+		}
+		if len(params) > 1 {
+			return "Function([" + strings.Join(params, ", ") + "], " + OutputForm(ex.Body) + ")"
+		}
+		return "Function(" + strings.Join(append(params, OutputForm(ex.Body)), ", ") + ")"
+	case List:
+		return ex.outputForm(parent)
+	}
+	return e.String()
+}
+
+// outputForm prints a compound expression, see OutputForm
+func (l List) outputForm(parent Precedence) string {
+	if len(l.elements) == 0 {
+		return "List()"
+	}
+	args := l.Tail()
+	head := l.Head()
+	switch head {
+	case symbol.List:
+		return "[" + outputArguments(args) + "]"
+	case symbol.HoldForm:
+		if len(args) == 1 {
+			return outputForm(args[0], parent)
+		}
+	case symbol.Plus:
+		if len(args) > 1 {
+			return outputSum(args, parent)
+		}
+	case symbol.Times:
+		if len(args) > 1 {
+			return outputProduct(args, parent)
+		}
+	case symbol.Blank, symbol.BlankSequence, symbol.BlankNullSequence:
+		if blank, ok := outputBlank(l); ok {
+			return blank
+		}
+	case symbol.Pattern:
+		// Pattern(x, Blank(Integer)) is x_Integer
+		if len(args) == 2 {
+			if blank, ok := outputBlank(args[1]); ok {
+				if name, ok := args[0].(Symbol); ok {
+					return name.String() + blank
+				}
+			}
+		}
+	case symbol.Power:
+		// x^-1 is 1 / x
+		if len(args) == 2 {
+			if _, _, ok := reciprocal(l); ok {
+				return outputProduct([]Expr{l}, parent)
+			}
+		}
+	}
+	if op, ok := outputOperators[head]; ok && (len(args) == 2 || op.nary && len(args) > 2) {
+		return outputInfix(args, op, parent, outputForm)
+	}
+	return outputForm(head, PrecedencePostfix) + "(" + outputArguments(args) + ")"
+}
+
+// outputBlank prints Blank(), BlankSequence(h) and BlankNullSequence
+// as _, __h and ___
+func outputBlank(e Expr) (string, bool) {
+	l, ok := e.(List)
+	if !ok || l.Length() > 1 {
+		return "", false
+	}
+	var blank string
+	switch l.Head() {
+	case symbol.Blank:
+		blank = "_"
+	case symbol.BlankSequence:
+		blank = "__"
+	case symbol.BlankNullSequence:
+		blank = "___"
+	default:
+		return "", false
+	}
+	if l.Length() == 0 {
+		return blank, true
+	}
+	if h, ok := l.Tail()[0].(Symbol); ok {
+		return blank + h.String(), true
+	}
+	return "", false
+}
+
+// outputArguments prints a comma separated argument list
+func outputArguments(args []Expr) string {
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		parts[i] = OutputForm(arg)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// outputInfix joins args with an operator, each printed by format. The
+// operand on the side the operator does not group from binds one level
+// tighter, so a - (b - c) and (a ^ b) ^ c keep their parentheses.
+func outputInfix(args []Expr, op outputOperator, parent Precedence, format func(Expr, Precedence) string) string {
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		prec := op.precedence + 1
+		if !op.rightAssoc && i == 0 || op.rightAssoc && i == len(args)-1 {
+			prec = op.precedence
+		}
+		parts[i] = format(arg, prec)
+	}
+	return parenthesize(strings.Join(parts, op.op), op.precedence, parent)
+}
+
+// outputSum prints Plus, turning negative terms into subtraction
+func outputSum(terms []Expr, parent Precedence) string {
+	var sb strings.Builder
+	sb.WriteString(outputForm(terms[0], PrecedenceSum))
+	for _, term := range terms[1:] {
+		if neg, ok := negated(term); ok {
+			sb.WriteString(" - ")
+			sb.WriteString(outputForm(neg, PrecedenceSum+1))
+			continue
+		}
+		sb.WriteString(" + ")
+		sb.WriteString(outputForm(term, PrecedenceSum+1))
+	}
+	return parenthesize(sb.String(), PrecedenceSum, parent)
+}
+
+// outputProduct prints Times: a negative coefficient becomes a leading
+// minus, and factors with a negative exponent move below a division
+func outputProduct(factors []Expr, parent Precedence) string {
+	if neg, ok := negated(ListFrom(symbol.Times, factors...)); ok {
+		return parenthesize("-"+outputForm(neg, PrecedenceProduct), PrecedenceSum, parent)
+	}
+
+	var num, den []Expr
+	for _, f := range factors {
+		if base, exp, ok := reciprocal(f); ok {
+			if exp.Equal(NewInteger(1)) {
+				den = append(den, base)
+			} else {
+				den = append(den, ListFrom(symbol.Power, base, exp))
+			}
+			continue
+		}
+		num = append(num, f)
+	}
+	if len(den) == 0 {
+		return outputInfix(num, outputOperator{op: " * ", precedence: PrecedenceProduct}, parent, outputForm)
+	}
+
+	numerator := "1"
+	if len(num) > 0 {
+		numerator = outputFactors(num, PrecedenceDivide)
+	}
+	denominator := outputFactors(den, PrecedenceDivide+1)
+	return parenthesize(numerator+" / "+denominator, PrecedenceDivide, parent)
+}
+
+// outputFactors prints one or more factors as a product
+func outputFactors(factors []Expr, parent Precedence) string {
+	if len(factors) == 1 {
+		return outputForm(factors[0], parent)
+	}
+	return outputProduct(factors, parent)
+}
+
+// negated returns -e if e is a negative number or a product with a
+// negative coefficient, so a + -2 * b can be shown as a - 2 * b
+func negated(e Expr) (Expr, bool) {
+	if n, ok := e.(Number); ok && n.Sign() < 0 {
+		return n.AsNeg(), true
+	}
+	l, ok := e.(List)
+	if !ok || l.Head() != symbol.Times || l.Length() < 2 {
+		return nil, false
+	}
+	args := l.Tail()
+	n, ok := args[0].(Number)
+	if !ok || n.Sign() >= 0 {
+		return nil, false
+	}
+	rest := args[1:]
+	if coeff := n.AsNeg(); !coeff.Equal(NewInteger(1)) {
+		rest = append([]Expr{coeff}, rest...)
+	}
+	if len(rest) == 1 {
+		return rest[0], true
+	}
+	return ListFrom(symbol.Times, rest...), true
+}
+
+// reciprocal splits base^-k into base and k
+func reciprocal(e Expr) (Expr, Expr, bool) {
+	l, ok := e.(List)
+	if !ok || l.Head() != symbol.Power || l.Length() != 2 {
+		return nil, nil, false
+	}
+	args := l.Tail()
+	exp, ok := args[1].(Number)
+	if !ok || exp.Sign() >= 0 {
+		return nil, nil, false
+	}
+	return args[0], exp.AsNeg(), true
+}
+
+// parenthesize wraps s, printed at precedence prec, if its context binds
+// more tightly
+func parenthesize(s string, prec, parent Precedence) string {
+	if prec < parent {
+		return "(" + s + ")"
+	}
+	return s
+}
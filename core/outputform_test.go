@@ -0,0 +1,89 @@
+package core
+
+import "testing"
+
+func TestOutputForm(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Plus(1, 2)", "1 + 2"},
+		{"Plus(1, Times(2, 3))", "1 + 2 * 3"},
+		{"Times(Plus(1, 2), 3)", "(1 + 2) * 3"},
+		{"Times(Plus(a, b), Plus(c, d))", "(a + b) * (c + d)"},
+		{"Plus(a, Plus(b, c))", "a + (b + c)"},
+		{"Subtract(a, Subtract(b, c))", "a - (b - c)"},
+		{"Subtract(Subtract(a, b), c)", "a - b - c"},
+		{"Power(a, Power(b, c))", "a^b^c"},
+		{"Power(Power(a, b), c)", "(a^b)^c"},
+		{"Power(Plus(a, 1), 2)", "(a + 1)^2"},
+		{"Power(-2, 2)", "(-2)^2"},
+		{"Plus(a, Times(-1, b))", "a - b"},
+		{"Plus(a, Times(-2, b), -3)", "a - 2 * b - 3"},
+		{"Plus(a, Times(-1, Plus(b, c)))", "a - (b + c)"},
+		{"Times(-1, x)", "-x"},
+		{"Times(-1, x, y)", "-x * y"},
+		{"Power(Times(-1, x), 2)", "(-x)^2"},
+		{"Times(a, -2)", "a * (-2)"},
+		{"Times(x, Power(y, -1))", "x / y"},
+		{"Times(x, Power(y, -2))", "x / y^2"},
+		{"Times(x, Power(y, -1), Power(z, -1))", "x / (y * z)"},
+		{"Power(x, -1)", "1 / x"},
+		{"Divide(a, Times(b, c))", "a / (b * c)"},
+		{"Times(a, Divide(b, c))", "a * b / c"},
+		{"Divide(Plus(a, b), 2)", "(a + b) / 2"},
+		{"f(Plus(1, 2), [a, b])", "f(1 + 2, [a, b])"},
+		{"[Plus(x, 1), Times(2, x)]", "[x + 1, 2 * x]"},
+		{"Equal(Plus(a, 1), b)", "a + 1 == b"},
+		{"And(Less(a, b), Or(c, d))", "a < b && (c || d)"},
+		{"Set(x, Set(y, 1))", "x = y = 1"},
+		{"SetDelayed(f(x_), Times(x, 2))", "f(x_) := x * 2"},
+		{"Rule(a, Plus(b, 1))", "a: b + 1"},
+		{"ReplaceAll(x, Rule(x, 1))", "x /. x: 1"},
+		{"CompoundExpression(Set(a, 1), Plus(a, 1))", "a = 1; a + 1"},
+		{"HoldForm(Plus(1, 1))", "1 + 1"},
+		{`"hello"`, "hello"},
+		{`["a", 1]`, "[a, 1]"},
+		{"f(x_, y__Integer, ___)", "f(x_, y__Integer, ___)"},
+		{"Plus(x)", "Plus(x)"},
+		{"Set(a, b, c)", "Set(a, b, c)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			expr, err := ParseString(tt.input)
+			if err != nil {
+				t.Fatalf("ParseString(%q): %v", tt.input, err)
+			}
+			if got := OutputForm(expr); got != tt.expected {
+				t.Errorf("OutputForm(%s) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOutputForm_Rational(t *testing.T) {
+	half := NewRational(1, 2)
+	tests := []struct {
+		expr     Expr
+		expected string
+	}{
+		{half, "1/2"},
+		{ListFrom(NewSymbol("Power"), half, NewInteger(2)), "(1/2)^2"},
+		{ListFrom(NewSymbol("Plus"), NewSymbol("x"), NewRational(-1, 2)), "x - 1/2"},
+	}
+	for _, tt := range tests {
+		if got := OutputForm(tt.expr); got != tt.expected {
+			t.Errorf("OutputForm(%s) = %q, want %q", tt.expr, got, tt.expected)
+		}
+	}
+}
+
+func TestOutputForm_Association(t *testing.T) {
+	assoc := NewAssociation().
+		Set(NewString("a"), ListFrom(NewSymbol("Plus"), NewInteger(1), NewSymbol("x"))).
+		Set(NewSymbol("b"), NewList(NewSymbol("List"), NewInteger(2)))
+	if got, want := OutputForm(assoc), "{a: 1 + x, b: [2]}"; got != want {
+		t.Errorf("OutputForm(assoc) = %q, want %q", got, want)
+	}
+}
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -10,22 +11,27 @@ import (
 
 type Precedence int
 
+// Precedence levels are spaced out so new levels and user-defined
+// operators can be placed between them without renumbering.
 const (
-	_ Precedence = iota
-	PrecedenceLowest
-	PrecedenceCompound   // ; (compound statements)
-	PrecedenceAssign     // =, :=, =.
-	PrecedenceRule       // : (rule shorthand)
-	PrecedenceLogicalOr  // ||
-	PrecedenceLogicalAnd // &&
-	PrecedenceEquality   // ==, !=
-	PrecedenceComparison // <, >, <=, >=
-	PrecedenceSum        // +, -
-	PrecedenceProduct    // *
-	PrecedenceDivide     // /
-	PrecedenceUnary      // unary -x, +x (lower than power)
-	PrecedencePower      // ^ (right associative)
-	PrecedencePostfix    // high precedence postfix operators
+	PrecedenceLowest       Precedence = 10
+	PrecedenceCompound     Precedence = 20  // ; (compound statements)
+	PrecedenceAssign       Precedence = 30  // =, :=, =.
+	PrecedenceReplace      Precedence = 40  // /. (replace all)
+	PrecedenceRule         Precedence = 50  // : (rule shorthand)
+	PrecedenceCondition    Precedence = 60  // /; (pattern condition)
+	PrecedenceAlternatives Precedence = 65  // | (pattern alternatives)
+	PrecedenceRepeated     Precedence = 68  // .. and ... (repeated patterns)
+	PrecedenceLogicalOr    Precedence = 70  // ||
+	PrecedenceLogicalAnd   Precedence = 80  // &&
+	PrecedenceEquality     Precedence = 90  // ==, !=
+	PrecedenceComparison   Precedence = 100 // <, >, <=, >=
+	PrecedenceSum          Precedence = 110 // +, -
+	PrecedenceProduct      Precedence = 120 // *
+	PrecedenceDivide       Precedence = 130 // /
+	PrecedenceUnary        Precedence = 140 // unary -x, +x (lower than power)
+	PrecedencePower        Precedence = 150 // ^ (right associative)
+	PrecedencePostfix      Precedence = 160 // high precedence postfix operators
 )
 
 var precedences = map[TokenType]Precedence{
@@ -36,8 +42,15 @@ var precedences = map[TokenType]Precedence{
 	SET:          PrecedenceAssign,
 	SETDELAYED:   PrecedenceAssign,
 	UNSET:        PrecedenceAssign,
+	UPSET:        PrecedenceAssign,
+	UPSETDELAYED: PrecedenceAssign,
+	REPLACEALL:   PrecedenceReplace,
 	COLON:        PrecedenceRule,
 	RULEDELAYED:  PrecedenceRule,
+	CONDITION:    PrecedenceCondition,
+	PIPE:         PrecedenceAlternatives,
+	REPEATED:     PrecedenceRepeated,
+	REPEATEDNULL: PrecedenceRepeated,
 	OR:           PrecedenceLogicalOr,
 	AND:          PrecedenceLogicalAnd,
 	EQUAL:        PrecedenceEquality,
@@ -53,6 +66,7 @@ var precedences = map[TokenType]Precedence{
 	MULTIPLY:     PrecedenceProduct,
 	DIVIDE:       PrecedenceDivide,
 	CARET:        PrecedencePower,
+	QUESTION:     PrecedencePostfix, // x_?test binds tightly to its pattern
 	NOT:          PrecedenceUnary,
 }
 
@@ -61,6 +75,29 @@ type Parser struct {
 	currentToken Token
 	peekToken    Token
 	errors       []string
+	eofErrors    int            // errors found at the end of the input
+	operators    *OperatorTable // user-defined infix operators, may be nil
+}
+
+// ParseError is the error returned by Parse
+type ParseError struct {
+	Messages []string
+
+	// Incomplete is true when every error happened at the end of the
+	// input, such as an unclosed bracket or a trailing operator, so
+	// more input may complete the expression
+	Incomplete bool
+}
+
+func (e *ParseError) Error() string {
+	return "parse errors: " + strings.Join(e.Messages, "; ")
+}
+
+// IsIncomplete reports whether err is a ParseError for input that
+// ended before the expression was complete
+func IsIncomplete(err error) bool {
+	var perr *ParseError
+	return errors.As(err, &perr) && perr.Incomplete
 }
 
 func NewParser(lexer *Lexer) *Parser {
@@ -81,6 +118,9 @@ func (p *Parser) nextToken() {
 }
 
 func (p *Parser) addError(msg string) {
+	if p.currentToken.Type == EOF {
+		p.eofErrors++
+	}
 	p.errors = append(p.errors, fmt.Sprintf("Parse error at position %d: %s", p.currentToken.Position, msg))
 }
 
@@ -88,10 +128,16 @@ func (p *Parser) Errors() []string {
 	return p.errors
 }
 
+// Parse parses a single expression, which must be followed by the end of
+// the input.  See ParseAll for a series of expressions.
 func (p *Parser) Parse() (Expr, error) {
 	expr := p.parseExpression()
-	if len(p.errors) > 0 {
-		return nil, fmt.Errorf("parse errors: %s", strings.Join(p.errors, "; "))
+	if len(p.errors) == 0 && p.currentToken.Type != EOF {
+		// such as the ')' of "f(1))" or the 2 of "1 2"
+		p.addError(fmt.Sprintf("unexpected token after the expression: %s", p.currentToken.String()))
+	}
+	if err := p.parseError(); err != nil {
+		return nil, err
 	}
 	if expr == nil {
 		// triggered when input is nothing
@@ -100,6 +146,40 @@ func (p *Parser) Parse() (Expr, error) {
 	return expr, nil
 }
 
+// ParseAll parses expressions until the end of the input, for programs
+// that are a series of statements: "x = 1\ny = x + 1"
+func (p *Parser) ParseAll() ([]Expr, error) {
+	var exprs []Expr
+	for p.currentToken.Type != EOF && len(p.errors) == 0 {
+		start := p.currentToken
+		expr := p.parseExpression()
+		if expr == nil || p.currentToken == start {
+			// nothing could be parsed here, such as a stray ')'
+			p.addError(fmt.Sprintf("unexpected token: %s", p.currentToken.String()))
+			break
+		}
+		exprs = append(exprs, expr)
+	}
+	if err := p.parseError(); err != nil {
+		return nil, err
+	}
+	return exprs, nil
+}
+
+// parseError returns the errors found so far as a *ParseError, or nil
+func (p *Parser) parseError() error {
+	if IsCustomOperatorToken(p.currentToken.Type) {
+		p.addError(fmt.Sprintf("operator '%s' is not defined", p.currentToken.Value))
+	}
+	if len(p.errors) > 0 {
+		return &ParseError{
+			Messages:   p.errors,
+			Incomplete: p.eofErrors == len(p.errors),
+		}
+	}
+	return nil
+}
+
 func (p *Parser) parseExpression() Expr {
 	return p.parseInfixExpression(PrecedenceLowest)
 }
@@ -138,6 +218,8 @@ func (p *Parser) ParseAtom() Expr {
 		expr = p.parseSymbolOrList()
 	case UNDERSCORE:
 		expr = p.parseUnderscorePattern()
+	case PERCENT:
+		expr = p.parsePercent()
 	case INTEGER:
 		expr = p.parseInteger()
 		p.nextToken()
@@ -405,6 +487,9 @@ func (p *Parser) unescapeString(s string) string {
 }
 
 func (p *Parser) currentPrecedence() Precedence {
+	if op, ok := p.operators.Lookup(p.currentToken.Type); ok {
+		return op.Precedence
+	}
 	if prec, ok := precedences[p.currentToken.Type]; ok {
 		return prec
 	}
@@ -413,10 +498,11 @@ func (p *Parser) currentPrecedence() Precedence {
 
 func (p *Parser) IsInfixOperator(tokenType TokenType) bool {
 	switch tokenType {
-	case SEMICOLON, SET, SETDELAYED, UNSET, COLON, RULEDELAYED, OR, AND, EQUAL, UNEQUAL, SAMEQ, UNSAMEQ, LESS, GREATER, LESSEQUAL, GREATEREQUAL, PLUS, MINUS, MULTIPLY, DIVIDE, CARET:
+	case SEMICOLON, SET, SETDELAYED, UNSET, UPSET, UPSETDELAYED, REPLACEALL, COLON, RULEDELAYED, CONDITION, PIPE, REPEATED, REPEATEDNULL, OR, AND, EQUAL, UNEQUAL, SAMEQ, UNSAMEQ, LESS, GREATER, LESSEQUAL, GREATEREQUAL, PLUS, MINUS, MULTIPLY, DIVIDE, CARET, QUESTION:
 		return true
 	default:
-		return false
+		_, ok := p.operators.Lookup(tokenType)
+		return ok
 	}
 }
 
@@ -424,8 +510,8 @@ func (p *Parser) parseInfixOperation(left Expr) Expr {
 	operator := p.currentToken
 	precedence := p.currentPrecedence()
 
-	// Special case for UNSET: it's a postfix unary operator
-	if operator.Type == UNSET {
+	// Special case for UNSET and repeated patterns: they are postfix unary operators
+	if operator.Type == UNSET || operator.Type == REPEATED || operator.Type == REPEATEDNULL {
 		p.nextToken()
 		return p.createInfixExpr(operator.Type, left, nil)
 	}
@@ -439,7 +525,24 @@ func (p *Parser) parseInfixOperation(left Expr) Expr {
 
 	p.nextToken()
 
+	// PatternTest (?) takes a single atom, symbol or call as its test
+	if operator.Type == QUESTION {
+		right := p.parseInfixExpression(PrecedencePostfix)
+		if right == nil {
+			p.addError(fmt.Sprintf("incomplete expression: expected operand after '%s'", operator.Value))
+			return left
+		}
+		return p.createInfixExpr(operator.Type, left, right)
+	}
+
 	// Power (^) is right-associative, so use precedence - 1
+	if op, ok := p.operators.Lookup(operator.Type); ok && op.RightAssoc {
+		precedence--
+	}
+	// Assignments are right-associative: f(n_) := f(n) = rhs
+	if operator.Type == SET || operator.Type == SETDELAYED || operator.Type == UPSET || operator.Type == UPSETDELAYED {
+		precedence--
+	}
 	if operator.Type == CARET {
 		right := p.parseInfixExpression(precedence - 1)
 		if right == nil {
@@ -498,10 +601,33 @@ func (p *Parser) createInfixExpr(operator TokenType, left, right Expr) Expr {
 		return ListFrom(symbol.SetDelayed, left, right)
 	case UNSET:
 		return ListFrom(symbol.Unset, left)
+	case UPSET:
+		return ListFrom(symbol.UpSet, left, right)
+	case UPSETDELAYED:
+		return ListFrom(symbol.UpSetDelayed, left, right)
+	case REPEATED:
+		return ListFrom(symbol.Repeated, left)
+	case REPEATEDNULL:
+		return ListFrom(symbol.RepeatedNull, left)
 	case COLON:
 		return ListFrom(symbol.Rule, left, right)
 	case RULEDELAYED:
 		return ListFrom(symbol.RuleDelayed, left, right)
+	case REPLACEALL:
+		return ListFrom(symbol.ReplaceAll, left, right)
+	case CONDITION:
+		return ListFrom(symbol.Condition, left, right)
+	case PIPE:
+		// Flatten a | b | c into a single Alternatives
+		if leftList, ok := left.(List); ok {
+			if leftList.Head() == symbol.Alternatives {
+				elements := make([]Expr, leftList.Length()+2)
+				copy(elements, leftList.AsSlice())
+				elements[len(elements)-1] = right
+				return NewListFromExprs(elements...)
+			}
+		}
+		return ListFrom(symbol.Alternatives, left, right)
 	case OR:
 		return ListFrom(symbol.Or, left, right)
 	case AND:
@@ -515,13 +641,13 @@ func (p *Parser) createInfixExpr(operator TokenType, left, right Expr) Expr {
 	case UNSAMEQ:
 		return ListFrom(symbol.UnsameQ, left, right)
 	case LESS:
-		return ListFrom(symbol.Less, left, right)
+		return flattenInfix(symbol.Less, left, right)
 	case GREATER:
-		return ListFrom(symbol.Greater, left, right)
+		return flattenInfix(symbol.Greater, left, right)
 	case LESSEQUAL:
-		return ListFrom(symbol.LessEqual, left, right)
+		return flattenInfix(symbol.LessEqual, left, right)
 	case GREATEREQUAL:
-		return ListFrom(symbol.GreaterEqual, left, right)
+		return flattenInfix(symbol.GreaterEqual, left, right)
 	case PLUS:
 		// Flatten nested Plus expressions into a single flat list
 		if leftList, ok := left.(List); ok {
@@ -552,12 +678,28 @@ func (p *Parser) createInfixExpr(operator TokenType, left, right Expr) Expr {
 		return ListFrom(symbol.Divide, left, right)
 	case CARET:
 		return ListFrom(symbol.Power, left, right)
+	case QUESTION:
+		return ListFrom(symbol.PatternTest, left, right)
 	default:
+		if op, ok := p.operators.Lookup(operator); ok {
+			return ListFrom(op.Head, left, right)
+		}
 		p.addError(fmt.Sprintf("unknown infix operator: %d", operator))
 		return nil
 	}
 }
 
+// flattenInfix chains a op b op c into a single op(a, b, c)
+func flattenInfix(head Expr, left, right Expr) Expr {
+	if leftList, ok := left.(List); ok && leftList.Head() == head {
+		elements := make([]Expr, leftList.Length()+2)
+		copy(elements, leftList.AsSlice())
+		elements[len(elements)-1] = right
+		return NewListFromExprs(elements...)
+	}
+	return ListFrom(head, left, right)
+}
+
 func (p *Parser) createPrefixExpr(operator TokenType, operand Expr) Expr {
 	switch operator {
 	case MINUS:
@@ -789,8 +931,10 @@ func (p *Parser) parseUnderscorePattern() Expr {
 
 	// Check if there's a type after the underscores
 	var typeName string
+	end := underscoreToken.Position + len(underscoreToken.Value)
 	if p.currentToken.Type == SYMBOL {
 		typeName = p.currentToken.Value
+		end = p.currentToken.Position + len(p.currentToken.Value)
 		p.nextToken()
 	}
 
@@ -816,6 +960,12 @@ func (p *Parser) parseUnderscorePattern() Expr {
 		}
 	}
 
+	// _. is an Optional with the default of the function, see Default
+	if underscoreCount == 1 && p.currentToken.Type == DOT && p.currentToken.Position == end {
+		p.nextToken() // consume '.'
+		return ListFrom(symbol.Optional, blankExpr)
+	}
+
 	// Anonymous pattern - just return the blank expression
 	return blankExpr
 }
@@ -829,8 +979,10 @@ func (p *Parser) parsePatternFromSymbol(varName string) Expr {
 
 	// Check if there's a type after the underscores
 	var typeName string
+	end := underscoreToken.Position + len(underscoreToken.Value)
 	if p.currentToken.Type == SYMBOL {
 		typeName = p.currentToken.Value
+		end = p.currentToken.Position + len(p.currentToken.Value)
 		p.nextToken()
 	}
 
@@ -857,7 +1009,39 @@ func (p *Parser) parsePatternFromSymbol(varName string) Expr {
 	}
 
 	// Named pattern - wrap in Pattern(varName, blankExpr)
-	return ListFrom(symbol.Pattern, NewSymbol(varName), blankExpr)
+	pattern := ListFrom(symbol.Pattern, NewSymbol(varName), blankExpr)
+
+	// x_:default with no space before the colon is an Optional, while
+	// x_ : value is still a Rule
+	if p.currentToken.Type == COLON && p.currentToken.Position == end {
+		p.nextToken() // consume ':'
+		def := p.parseInfixExpression(PrecedenceAlternatives)
+		if def == nil {
+			p.addError("expected default value after ':'")
+			return pattern
+		}
+		return ListFrom(symbol.Optional, pattern, def)
+	}
+	// x_. is an Optional with the default of the function, see Default
+	if underscoreCount == 1 && p.currentToken.Type == DOT && p.currentToken.Position == end {
+		p.nextToken() // consume '.'
+		return ListFrom(symbol.Optional, pattern)
+	}
+	return pattern
+}
+
+// parsePercent handles references to earlier results: % is Out(),
+// %% is Out(-2), %%% is Out(-3) and %n is Out(n)
+func (p *Parser) parsePercent() Expr {
+	value := p.currentToken.Value
+	p.nextToken()
+	if value == "%" {
+		return ListFrom(symbol.Out)
+	}
+	if n, err := strconv.ParseInt(value[1:], 10, 64); err == nil {
+		return ListFrom(symbol.Out, NewInteger(n))
+	}
+	return ListFrom(symbol.Out, NewInteger(-int64(len(value))))
 }
 
 // parseFunctionShorthand handles the & postfix operator: expr & -> Function(expr)
@@ -135,8 +135,8 @@ func TestParser_Parse(t *testing.T) {
 		{
 			name:     "missing opening parenthesis",
 			input:    "Plus 1, 2)",
-			expected: "Plus", // Parser will parse "Plus" as a symbol, then encounter issues with rest
-			hasError: false,
+			expected: "",
+			hasError: true,
 		},
 		{
 			name:     "invalid token",
@@ -159,8 +159,20 @@ func TestParser_Parse(t *testing.T) {
 		{
 			name:     "multiple expressions",
 			input:    "Plus(1, 2) Times(3, 4)",
-			expected: "Plus(1, 2)", // Parser should parse first expression
-			hasError: false,
+			expected: "", // input after the first expression is an error
+			hasError: true,
+		},
+		{
+			name:     "extra closing parenthesis",
+			input:    "f(1))",
+			expected: "",
+			hasError: true,
+		},
+		{
+			name:     "expressions on separate lines",
+			input:    "x = 1\nx + 1",
+			expected: "",
+			hasError: true,
 		},
 		{
 			name:     "simple addition",
@@ -264,6 +276,18 @@ func TestParser_Parse(t *testing.T) {
 			expected: "Set(x, Plus(y, z))",
 			hasError: false,
 		},
+		{
+			name:     "upsetdelayed",
+			input:    "area(c_Circle) ^:= 1",
+			expected: "UpSetDelayed(area(Pattern(c, Blank(Circle))), 1)",
+			hasError: false,
+		},
+		{
+			name:     "assignment is right associative",
+			input:    "f(n_) := f(n) = n",
+			expected: "SetDelayed(f(Pattern(n, Blank())), Set(f(n), n))",
+			hasError: false,
+		},
 		{
 			name:     "equality operator",
 			input:    "x == y",
@@ -300,6 +324,18 @@ func TestParser_Parse(t *testing.T) {
 			expected: "GreaterEqual(x, y)",
 			hasError: false,
 		},
+		{
+			name:     "chained less than",
+			input:    "x < y < z",
+			expected: "Less(x, y, z)",
+			hasError: false,
+		},
+		{
+			name:     "mixed comparisons are not chained",
+			input:    "x < y <= z",
+			expected: "LessEqual(Less(x, y), z)",
+			hasError: false,
+		},
 		{
 			name:     "logical and operator",
 			input:    "x && y",
@@ -324,6 +360,160 @@ func TestParser_Parse(t *testing.T) {
 			expected: "UnsameQ(x, y)",
 			hasError: false,
 		},
+		{
+			name:     "replace all operator",
+			input:    "x /. a : b",
+			expected: "ReplaceAll(x, Rule(a, b))",
+			hasError: false,
+		},
+		{
+			name:     "replace all with rule list",
+			input:    "f(x) /. [x : 1, y : 2]",
+			expected: "ReplaceAll(f(x), List(Rule(x, 1), Rule(y, 2)))",
+			hasError: false,
+		},
+		{
+			name:     "replace all binds looser than arithmetic",
+			input:    "x + y /. x : 2 * z",
+			expected: "ReplaceAll(Plus(x, y), Rule(x, Times(2, z)))",
+			hasError: false,
+		},
+		{
+			name:     "replace all binds tighter than assignment",
+			input:    "y = x /. x : 1",
+			expected: "Set(y, ReplaceAll(x, Rule(x, 1)))",
+			hasError: false,
+		},
+		{
+			name:     "replace all is left associative",
+			input:    "x /. x : y /. y : z",
+			expected: "ReplaceAll(ReplaceAll(x, Rule(x, y)), Rule(y, z))",
+			hasError: false,
+		},
+		{
+			name:     "condition on pattern",
+			input:    "x_ /; x > 0",
+			expected: "Condition(Pattern(x, Blank()), Greater(x, 0))",
+			hasError: false,
+		},
+		{
+			name:     "condition on definition body",
+			input:    "f(x_) := x /; x >= 0",
+			expected: "SetDelayed(f(Pattern(x, Blank())), Condition(x, GreaterEqual(x, 0)))",
+			hasError: false,
+		},
+		{
+			name:     "condition binds tighter than rule",
+			input:    "x_ /; x > 0 : y",
+			expected: "Rule(Condition(Pattern(x, Blank()), Greater(x, 0)), y)",
+			hasError: false,
+		},
+		{
+			name:     "condition binds looser than logical operators",
+			input:    "x_ /; x > 0 && x < 5",
+			expected: "Condition(Pattern(x, Blank()), And(Greater(x, 0), Less(x, 5)))",
+			hasError: false,
+		},
+		{
+			name:     "pattern test",
+			input:    "x_?IntegerQ",
+			expected: "PatternTest(Pattern(x, Blank()), IntegerQ)",
+			hasError: false,
+		},
+		{
+			name:     "pattern test with function call",
+			input:    "f(x_?Function(v, v > 1))",
+			expected: "f(PatternTest(Pattern(x, Blank()), Function(v, Greater(v, 1))))",
+			hasError: false,
+		},
+		{
+			name:     "pattern test binds tighter than arithmetic",
+			input:    "a + _?NumberQ",
+			expected: "Plus(a, PatternTest(Blank(), NumberQ))",
+			hasError: false,
+		},
+		{
+			name:     "pattern test missing predicate",
+			input:    "x_?",
+			hasError: true,
+		},
+		{
+			name:     "alternatives",
+			input:    "x_Integer | x_Real",
+			expected: "Alternatives(Pattern(x, Blank(Integer)), Pattern(x, Blank(Real)))",
+			hasError: false,
+		},
+		{
+			name:     "alternatives are flattened",
+			input:    "a | b | c",
+			expected: "Alternatives(a, b, c)",
+			hasError: false,
+		},
+		{
+			name:     "alternatives bind tighter than condition",
+			input:    "x_ | y_ /; True",
+			expected: "Condition(Alternatives(Pattern(x, Blank()), Pattern(y, Blank())), True)",
+			hasError: false,
+		},
+		{
+			name:     "alternatives bind looser than logical or",
+			input:    "a || b | c",
+			expected: "Alternatives(Or(a, b), c)",
+			hasError: false,
+		},
+		{
+			name:     "repeated pattern",
+			input:    "f(x_Integer..)",
+			expected: "f(Repeated(Pattern(x, Blank(Integer))))",
+			hasError: false,
+		},
+		{
+			name:     "repeated null pattern",
+			input:    "f(a, b...)",
+			expected: "f(a, RepeatedNull(b))",
+			hasError: false,
+		},
+		{
+			name:     "repeated binds tighter than alternatives",
+			input:    "a | b..",
+			expected: "Alternatives(a, Repeated(b))",
+			hasError: false,
+		},
+		{
+			name:     "optional pattern",
+			input:    "f(x_, y_:10)",
+			expected: "f(Pattern(x, Blank()), Optional(Pattern(y, Blank()), 10))",
+			hasError: false,
+		},
+		{
+			name:     "typed optional pattern",
+			input:    "f(n_Integer:-1)",
+			expected: "f(Optional(Pattern(n, Blank(Integer)), -1))",
+			hasError: false,
+		},
+		{
+			name:     "optional pattern with the default of the function",
+			input:    "f(x_., n_Integer., _.)",
+			expected: "f(Optional(Pattern(x, Blank())), Optional(Pattern(n, Blank(Integer))), Optional(Blank()))",
+			hasError: false,
+		},
+		{
+			name:     "pattern with spaced dot",
+			input:    "f(x_ .)",
+			hasError: true,
+		},
+		{
+			name:     "pattern with spaced colon is a rule",
+			input:    "x_ : 1",
+			expected: "Rule(Pattern(x, Blank()), 1)",
+			hasError: false,
+		},
+		{
+			name:     "pattern with spaced colon in a definition is a rule",
+			input:    "f(x_ : 1, y_: 2, k_ : v_) := x",
+			expected: "SetDelayed(f(Rule(Pattern(x, Blank()), 1), Optional(Pattern(y, Blank()), 2), Rule(Pattern(k, Blank()), Pattern(v, Blank()))), x)",
+			hasError: false,
+		},
 		{
 			name:     "comparison precedence",
 			input:    "x + y == z * w",
@@ -661,6 +851,92 @@ func TestParser_ErrorHandling(t *testing.T) {
 	}
 }
 
+func TestParser_Percent(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"%", "Out()"},
+		{"%%", "Out(-2)"},
+		{"%%%", "Out(-3)"},
+		{"%7", "Out(7)"},
+		{"%*2", "Times(Out(), 2)"},
+		{"f(%%, %1)", "f(Out(-2), Out(1))"},
+	}
+
+	for _, tt := range tests {
+		expr, err := ParseString(tt.input)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.input, err)
+			continue
+		}
+		if expr.String() != tt.expected {
+			t.Errorf("%q: expected %s, got %s", tt.input, tt.expected, expr)
+		}
+	}
+}
+
+func TestParser_IncompleteInput(t *testing.T) {
+	tests := []struct {
+		input      string
+		incomplete bool
+	}{
+		{"Plus(1, 2", true},
+		{"[1, 2", true},
+		{"{a: 1", true},
+		{"(1 + 2", true},
+		{"1 +", true},
+		{"f(x_) :=", true},
+		{"Plus(1 @ 2)", false},
+		{"@invalid", false},
+		{"1 + * 2 +", false},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseString(tt.input)
+		if err == nil {
+			t.Errorf("%q: expected error but got none", tt.input)
+			continue
+		}
+		if got := IsIncomplete(err); got != tt.incomplete {
+			t.Errorf("%q: expected IsIncomplete %v, got %v for %v", tt.input, tt.incomplete, got, err)
+		}
+	}
+}
+
+func TestParser_ParseAll(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"", nil},
+		{"1", []string{"1"}},
+		{"x = 1\ny = x + 1", []string{"Set(x, 1)", "Set(y, Plus(x, 1))"}},
+		{"a; b\nc", []string{"CompoundExpression(a, b)", "c"}},
+		{"f(x,\n  y) g(z)", []string{"f(x, y)", "g(z)"}},
+	}
+	for _, tt := range tests {
+		exprs, err := NewParser(NewLexer(tt.input)).ParseAll()
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", tt.input, err)
+			continue
+		}
+		var got []string
+		for _, e := range exprs {
+			got = append(got, e.String())
+		}
+		if strings.Join(got, " | ") != strings.Join(tt.expected, " | ") {
+			t.Errorf("%q: got %v, want %v", tt.input, got, tt.expected)
+		}
+	}
+
+	for _, bad := range []string{"1)", "x = 1\n]", "x = (1"} {
+		if _, err := NewParser(NewLexer(bad)).ParseAll(); err == nil {
+			t.Errorf("%q: expected an error", bad)
+		}
+	}
+}
+
 func TestParser_StringEscaping(t *testing.T) {
 	tests := []struct {
 		name     string
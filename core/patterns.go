@@ -19,6 +19,7 @@ type PatternInfo struct {
 	Type     PatternType
 	VarName  string // Variable name (empty for anonymous patterns)
 	TypeName string // Type constraint (empty for no constraint)
+	Head     Expr   // Head constraint that is not a symbol, as f(1) in Blank(f(1)), or nil
 }
 
 // PatternSpecificity represents the specificity of a pattern for ordering
@@ -104,6 +105,8 @@ func GetSymbolicPatternInfo(expr Expr) PatternInfo {
 		if typeExpr != nil {
 			if typeAtom, ok := typeExpr.(Symbol); ok {
 				info.TypeName = typeAtom.String()
+			} else {
+				info.Head = typeExpr
 			}
 		}
 	}
@@ -113,7 +116,9 @@ func GetSymbolicPatternInfo(expr Expr) PatternInfo {
 
 // Type matching functions
 
-// MatchesType checks if an expression matches a given type name
+// MatchesType checks if an expression matches a given type name. The
+// type is the name of the head, either a builtin type such as Integer or
+// any other symbol: x_Point matches Point(3, 4).
 func MatchesType(expr Expr, typeName string) bool {
 	if typeName == "" {
 		return true // No type constraint
@@ -123,7 +128,18 @@ func MatchesType(expr Expr, typeName string) bool {
 		_, ok := GetNumericValue(expr)
 		return ok
 	}
-	return expr.Head().String() == typeName
+	head, ok := expr.Head().(Symbol)
+	return ok && head.String() == typeName
+}
+
+// MatchesHead checks if an expression has the head the blank of the
+// pattern requires, see MatchesType. A head that is not a symbol, as in
+// Blank(f(1)), must be equal.
+func (info PatternInfo) MatchesHead(expr Expr) bool {
+	if info.Head != nil {
+		return expr.Head().Equal(info.Head)
+	}
+	return MatchesType(expr, info.TypeName)
 }
 
 // IsBuiltinType checks if a type name is a built-in type
@@ -142,6 +158,42 @@ func IsBuiltinType(typeName string) bool {
 
 // GetPatternSpecificity calculates the specificity of a pattern for ordering
 func GetPatternSpecificity(pattern Expr) PatternSpecificity {
+	// A Condition or PatternTest is slightly more specific than its pattern alone
+	if p, cond := IsCondition(pattern); cond != nil {
+		return GetPatternSpecificity(p) + 1
+	}
+	if p, pred := IsPatternTest(pattern); pred != nil {
+		return GetPatternSpecificity(p) + 1
+	}
+	// An Optional is slightly less specific, so definitions with fewer
+	// optional arguments are tried first
+	if p, _ := IsOptional(pattern); p != nil {
+		return GetPatternSpecificity(p) - 1
+	}
+	if pattern.Head() == symbol.HoldPattern && pattern.Length() == 1 {
+		return GetPatternSpecificity(pattern.(List).Tail()[0])
+	}
+	// Verbatim is a literal
+	if pattern.Head() == symbol.Verbatim && pattern.Length() == 1 {
+		return SpecificityLiteral * 100
+	}
+	// Except is slightly more specific than the pattern it constrains
+	if _, p := IsExcept(pattern); p != nil {
+		return GetPatternSpecificity(p) + 1
+	}
+	// A repeated pattern is a little less specific than a single match
+	if p, _, _ := IsRepeated(pattern); p != nil {
+		return GetPatternSpecificity(p) - 1
+	}
+	// Alternatives are as general as their most general choice
+	if plist := IsAlternatives(pattern); len(plist) > 0 {
+		spec := GetPatternSpecificity(plist[0])
+		for _, p := range plist[1:] {
+			spec = min(spec, GetPatternSpecificity(p))
+		}
+		return spec
+	}
+
 	// Check if it's a symbol.ic pattern
 	if isPattern, _, blankExpr := IsSymbolicPattern(pattern); isPattern {
 		return GetBlankExprSpecificity(blankExpr)
@@ -219,7 +271,9 @@ func GetTypeSpecificity(typeName string) PatternSpecificity {
 func GetPatternVariableSpecificity(info PatternInfo) PatternSpecificity {
 	// Base specificity from type constraint
 	var baseSpecificity PatternSpecificity
-	if info.TypeName == "" {
+	if info.Head != nil {
+		baseSpecificity = SpecificityUserType
+	} else if info.TypeName == "" {
 		baseSpecificity = SpecificityGeneral
 	} else {
 		baseSpecificity = GetTypeSpecificity(info.TypeName)
@@ -263,6 +317,13 @@ func CalculateCompoundSpecificity(pattern List) CompoundSpecificity {
 	// Calculate argument specificities
 	totalArgScore := 0
 	for _, e := range pattern.Tail() {
+		// Optional arguments do not count towards the arity, and each one
+		// makes the pattern a little less specific
+		if p, _ := IsOptional(e); p != nil {
+			cs.ArgsCount--
+			totalArgScore--
+			continue
+		}
 		argSpec := GetPatternSpecificity(e)
 		cs.ArgsSpecificity = append(cs.ArgsSpecificity, argSpec)
 		totalArgScore += int(argSpec)
@@ -282,6 +343,9 @@ func PatternsEqual(pattern1, pattern2 Expr) bool {
 
 	// If both are patterns, compare their structure (ignoring variable names)
 	if info1.Type != PatternUnknown && info2.Type != PatternUnknown {
+		if (info1.Head == nil) != (info2.Head == nil) || (info1.Head != nil && !info1.Head.Equal(info2.Head)) {
+			return false
+		}
 		return info1.Type == info2.Type && info1.TypeName == info2.TypeName
 	}
 
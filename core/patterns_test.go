@@ -70,6 +70,11 @@ func TestMatchesType(t *testing.T) {
 		{NewInteger(42), "", true}, // No constraint
 		{NewObjectExpr(NewSymbol("CustomType"), NewInteger(1)), "CustomType", true},
 		{NewObjectExpr(NewSymbol("CustomType"), NewInteger(1)), "OtherType", false},
+		{MustParse("Point(3, 4)"), "Point", true},
+		{MustParse("Point3D(1, 2, 2)"), "Point", false},
+		{MustParse("Complex(1, 2)"), "Complex", true},
+		{MustParse(`"Point"(1)`), "Point", false},
+		{MustParse("Point(1)(2)"), "Point", false},
 	}
 
 	for _, test := range tests {
@@ -80,6 +85,28 @@ func TestMatchesType(t *testing.T) {
 	}
 }
 
+func TestPatternInfo_MatchesHead(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		expr     string
+		expected bool
+	}{
+		{"p_Point", "Point(3, 4)", true},
+		{"p_Point", "Point3D(1, 2, 2)", false},
+		{"_", "Point(3, 4)", true},
+		{"Blank(f(1))", "f(1)(2)", true},
+		{"Blank(f(1))", "f(2)(2)", false},
+		{"Blank(f(1))", "f(1)", false},
+		{"Pattern(x, BlankSequence(f(1)))", "f(1)(2)", true},
+	}
+	for _, tt := range tests {
+		info := GetSymbolicPatternInfo(MustParse(tt.pattern))
+		if got := info.MatchesHead(MustParse(tt.expr)); got != tt.expected {
+			t.Errorf("%s MatchesHead(%s) = %v, want %v", tt.pattern, tt.expr, got, tt.expected)
+		}
+	}
+}
+
 func TestIsBuiltinType(t *testing.T) {
 	builtinTypes := []string{"Integer", "Real", "Number", "String", "Symbol", "List", "Rule", "ByteArray", "Association"}
 	for _, typeName := range builtinTypes {
@@ -224,3 +251,135 @@ func TestPatternMatcher(t *testing.T) {
 		}
 	}
 }
+
+// Test Condition matching with a caller-supplied test function
+func TestMatchWithTest_Condition(t *testing.T) {
+	// Condition(x_, x) with a test that accepts only True
+	pattern := ListFrom(symbol.Condition,
+		ListFrom(symbol.Pattern, NewSymbol("x"), ListFrom(symbol.Blank)),
+		NewSymbol("x"))
+	isTrue := func(e Expr) bool { return e == symbol.True }
+
+	if ok, bindings := MatchWithTest(symbol.True, pattern, isTrue); !ok || len(bindings) != 1 {
+		t.Errorf("expected match with one binding, got %v %v", ok, bindings)
+	}
+	if ok, bindings := MatchWithTest(symbol.False, pattern, isTrue); ok || len(bindings) != 0 {
+		t.Errorf("expected no match and no bindings, got %v %v", ok, bindings)
+	}
+	if ok, _ := MatchWithBindings(symbol.True, pattern); ok {
+		t.Error("Condition should not match without a test function")
+	}
+
+	// f(Condition(x_, x), y_) fails on the condition before binding y
+	fpattern := ListFrom(NewSymbol("f"), pattern, ListFrom(symbol.Pattern, NewSymbol("y"), ListFrom(symbol.Blank)))
+	if ok, _ := MatchWithTest(ListFrom(NewSymbol("f"), symbol.False, NewInteger(1)), fpattern, isTrue); ok {
+		t.Error("f(False, 1) should not match")
+	}
+	if ok, _ := MatchWithTest(ListFrom(NewSymbol("f"), symbol.True, NewInteger(1)), fpattern, isTrue); !ok {
+		t.Error("f(True, 1) should match")
+	}
+}
+
+// Test PatternTest matching with a caller-supplied test function
+func TestMatchWithTest_PatternTest(t *testing.T) {
+	// PatternTest(x_, ok) where the test accepts ok(1) only
+	pattern := ListFrom(symbol.PatternTest,
+		ListFrom(symbol.Pattern, NewSymbol("x"), ListFrom(symbol.Blank)),
+		NewSymbol("ok"))
+	isOne := func(e Expr) bool { return e.Equal(ListFrom(NewSymbol("ok"), NewInteger(1))) }
+
+	if ok, bindings := MatchWithTest(NewInteger(1), pattern, isOne); !ok || len(bindings) != 1 {
+		t.Errorf("expected match with one binding, got %v %v", ok, bindings)
+	}
+	if ok, bindings := MatchWithTest(NewInteger(2), pattern, isOne); ok || len(bindings) != 0 {
+		t.Errorf("expected no match and no bindings, got %v %v", ok, bindings)
+	}
+	if ok, _ := MatchWithBindings(NewInteger(1), pattern); ok {
+		t.Error("PatternTest should not match without a test function")
+	}
+}
+
+func TestMatchWithBindings_Except(t *testing.T) {
+	// Except(_Integer) and Except(0, x_Integer)
+	notInteger := ListFrom(symbol.Except, ListFrom(symbol.Blank, NewSymbol("Integer")))
+	nonZero := ListFrom(symbol.Except, NewInteger(0),
+		ListFrom(symbol.Pattern, NewSymbol("x"), ListFrom(symbol.Blank, NewSymbol("Integer"))))
+
+	if ok, _ := MatchWithBindings(NewSymbol("a"), notInteger); !ok {
+		t.Error("expected a to match Except(_Integer)")
+	}
+	if ok, _ := MatchWithBindings(NewInteger(1), notInteger); ok {
+		t.Error("expected 1 not to match Except(_Integer)")
+	}
+	if ok, bindings := MatchWithBindings(NewInteger(5), nonZero); !ok || len(bindings) != 1 {
+		t.Errorf("expected match with one binding, got %v %v", ok, bindings)
+	}
+	if ok, _ := MatchWithBindings(NewInteger(0), nonZero); ok {
+		t.Error("expected 0 not to match Except(0, x_Integer)")
+	}
+	if ok, _ := MatchWithBindings(NewSymbol("a"), nonZero); ok {
+		t.Error("expected a not to match Except(0, x_Integer)")
+	}
+}
+
+func TestMatchWithBindings_Verbatim(t *testing.T) {
+	blank := ListFrom(symbol.Blank)
+	verbatim := ListFrom(symbol.Verbatim, blank)
+
+	if ok, _ := MatchWithBindings(blank, verbatim); !ok {
+		t.Error("expected Blank() to match Verbatim(Blank())")
+	}
+	if ok, _ := MatchWithBindings(NewInteger(5), verbatim); ok {
+		t.Error("expected 5 not to match Verbatim(Blank())")
+	}
+	if ok, _ := MatchWithBindings(NewInteger(5), blank); !ok {
+		t.Error("expected 5 to match Blank()")
+	}
+	// a named pattern inside Verbatim binds nothing
+	named := MustParse("f(x_)")
+	if ok, bindings := MatchWithBindings(named, ListFrom(symbol.Verbatim, named)); !ok || len(bindings) != 0 {
+		t.Errorf("expected match with no bindings, got %v %v", ok, bindings)
+	}
+	if ok, _ := MatchWithBindings(MustParse("f(y_)"), ListFrom(symbol.Verbatim, named)); ok {
+		t.Error("expected f(y_) not to match Verbatim(f(x_))")
+	}
+}
+
+func TestSubstituteBindings_Sequence(t *testing.T) {
+	ok, bindings := MatchWithBindings(MustParse("f(a, [1, 2], b, c)"), MustParse("f(x_, y_, z__)"))
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	// only z is a sequence, y is bound to a list and is not spliced
+	got := SubstituteBindings(MustParse("g(x, y, z, [z])"), bindings)
+	if want := MustParse("g(a, [1, 2], b, c, [b, c])"); !got.Equal(want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	// an empty sequence leaves nothing behind
+	ok, bindings = MatchWithBindings(MustParse("f(a)"), MustParse("f(x_, z___)"))
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	if got := SubstituteBindings(MustParse("g(z, x, z)"), bindings); !got.Equal(MustParse("g(a)")) {
+		t.Errorf("got %s, want g(a)", got)
+	}
+}
+
+func TestMatchWithBindings_RepeatedSequence(t *testing.T) {
+	tests := []struct {
+		expr, pattern string
+		want          bool
+	}{
+		{"f(1, 2, 1, 2)", "f(x__, x__)", true},
+		{"f(1, 2, 3)", "f(x__, x__)", false},
+		{"f(1, 1)", "f(x__, x_)", true},
+		{"f(1, 2)", "f(x__, x_)", false},
+		{"f(1, 1)", "f(x_, x__)", true},
+		{"f(1, 2, 2)", "f(x_, x__)", false},
+	}
+	for _, tt := range tests {
+		if ok, _ := MatchWithBindings(MustParse(tt.expr), MustParse(tt.pattern)); ok != tt.want {
+			t.Errorf("MatchQ(%s, %s) = %v, want %v", tt.expr, tt.pattern, ok, tt.want)
+		}
+	}
+}
@@ -62,11 +62,11 @@ func (m rat64) StandardForm() Expr {
 }
 
 func (m rat64) Denom() machineInt {
-	return newMachineInt(m.b)
+	return machineInt(m.b)
 }
 
 func (m rat64) Num() machineInt {
-	return newMachineInt(m.a)
+	return machineInt(m.a)
 }
 
 func (m rat64) Neg() Rational {
@@ -122,5 +122,5 @@ func (m rat64) AsNeg() Expr {
 }
 func (m rat64) AsInv() Expr {
 	// TODO ERROR
-	return rat64{m.b, m.a}
+	return rat64{m.b, m.a}.StandardForm()
 }
@@ -400,6 +400,104 @@ var cases = []tc{
 		binding: "",
 		match:   true,
 	},
+	{
+		name:    "Repeated exact count",
+		expr:    "f(1,2,3)",
+		pattern: "f(Repeated(MatchHead(Integer), [3]))",
+		binding: "",
+		match:   true,
+	},
+	{
+		name:    "Repeated exact count, too few",
+		expr:    "f(1,2)",
+		pattern: "f(Repeated(MatchHead(Integer), [3]))",
+		binding: "",
+		match:   false,
+	},
+	{
+		name:    "Repeated exact count, too many",
+		expr:    "f(1,2,3,4)",
+		pattern: "f(Repeated(MatchHead(Integer), [3]))",
+		binding: "",
+		match:   false,
+	},
+	{
+		name:    "Repeated at least",
+		expr:    "f(1,2,3)",
+		pattern: "f(Repeated(MatchAny(), [2, Infinity]))",
+		binding: "",
+		match:   true,
+	},
+	{
+		name:    "Repeated at least, too few",
+		expr:    "f(1)",
+		pattern: "f(Repeated(MatchAny(), [2, Infinity]))",
+		binding: "",
+		match:   false,
+	},
+	{
+		name:    "Repeated range",
+		expr:    "[1,2]",
+		pattern: "[Repeated(MatchHead(Integer), [1, 2])]",
+		binding: "",
+		match:   true,
+	},
+	{
+		name:    "Repeated range, too many",
+		expr:    "[1,2,3]",
+		pattern: "[Repeated(MatchHead(Integer), [1, 2])]",
+		binding: "",
+		match:   false,
+	},
+	{
+		name:    "Repeated range, empty",
+		expr:    "[]",
+		pattern: "[Repeated(MatchHead(Integer), [1, 2])]",
+		binding: "",
+		match:   false,
+	},
+	{
+		name:    "Repeated range, wrong type",
+		expr:    "[1,a]",
+		pattern: "[Repeated(MatchHead(Integer), [1, 2])]",
+		binding: "",
+		match:   false,
+	},
+	{
+		name:    "Repeated unbounded",
+		expr:    "[1,2,3,4]",
+		pattern: "[Repeated(MatchHead(Integer))]",
+		binding: "",
+		match:   true,
+	},
+	{
+		name:    "Repeated at most",
+		expr:    "[1,2,3]",
+		pattern: "[Repeated(MatchHead(Integer), 2)]",
+		binding: "",
+		match:   false,
+	},
+	{
+		name:    "Repeated then literal",
+		expr:    "[1,2,a]",
+		pattern: "[Repeated(MatchHead(Integer), [1, 3]), a]",
+		binding: "",
+		match:   true,
+	},
+	{
+		name:    "RepeatedNull empty",
+		expr:    "[]",
+		pattern: "[RepeatedNull(MatchHead(Integer))]",
+		binding: "",
+		match:   true,
+	},
+	{
+		name:    "RepeatedNull at most",
+		expr:    "[1,2]",
+		pattern: "[RepeatedNull(MatchHead(Integer), 2)]",
+		binding: "",
+		match:   true,
+	},
 	/*
 		{
 			name:    "List with any head",
@@ -830,3 +928,34 @@ func BenchmarkSRECrazy(b *testing.B) {
 		})
 	}
 }
+
+// TestSRENestedQuantifiersBounded checks that nested quantifiers over a
+// long input keep at most one thread per instruction, so the work grows
+// linearly with the input.
+func TestSRENestedQuantifiersBounded(t *testing.T) {
+	p := MustParse("[Pattern(x, PatternSequence(MatchStar(MatchStar(MatchAny())), MatchStar(1))), 2]")
+	c := NewCompiler()
+	prog := c.compileNFAList(p.(List).Tail())
+
+	for _, n := range []int{10, 100, 1000} {
+		parts := make([]string, n)
+		for i := range parts {
+			parts[i] = "1"
+		}
+		args := MustParse("[" + strings.Join(parts, ",") + ", 2]").(List).Tail()
+
+		re := NewRegexp()
+		ok, captures := re.MatchList(prog, args)
+		if !ok {
+			t.Fatalf("n=%d: match failed", n)
+		}
+		x := captures.captures[prog.getSlot(NewSymbol("x"))]
+		if got := x.end - x.start; int(got) != n {
+			t.Errorf("n=%d: x captured %d elements", n, got)
+		}
+		if cap(re.currentList) > prog.Length() || cap(re.nextList) > prog.Length() {
+			t.Errorf("n=%d: %d and %d threads for %d instructions", n,
+				cap(re.currentList), cap(re.nextList), prog.Length())
+		}
+	}
+}
@@ -1,18 +1,16 @@
 package core
 
-import (
-	"github.com/client9/cardinal/core/symbol"
-)
-
-// needsSequenceSplicing determines if a substitution should be spliced (for sequence patterns)
-func needsSequenceSplicing(originalElem, newElem Expr, bindings PatternBindings) bool {
-	// Check if original element is a symbol that was bound to a List
-	if elemSym, ok := originalElem.(Symbol); ok {
-		if val := bindings.HasBinding(elemSym.String()); val != nil {
-			return val.Head() == symbol.List
-		}
+// sequenceBinding returns the elements bound to e, if e is the variable
+// of a sequence pattern
+func sequenceBinding(e Expr, bindings PatternBindings) ([]Expr, bool) {
+	sym, ok := e.(Symbol)
+	if !ok {
+		return nil, false
+	}
+	if b := bindings.find(sym.String()); b != nil && b.Sequence {
+		return b.Value.(List).Tail(), true
 	}
-	return false
+	return nil, false
 }
 
 // SubstituteBindings replaces pattern variables in an expression with their bound values
@@ -30,31 +28,17 @@ func SubstituteBindings(expr Expr, bindings PatternBindings) Expr {
 		changed := false
 
 		for i, elem := range e.AsSlice() {
-			newElem := SubstituteBindings(elem, bindings)
-
-			// Check if this is a sequence variable substitution that needs splicing
-			if i > 0 && needsSequenceSplicing(elem, newElem, bindings) {
-				// This is a sequence variable - splice its elements
-				if elemSym, ok := elem.(Symbol); ok {
-					if val := bindings.HasBinding(elemSym.String()); val != nil {
-						if boundList, ok := val.(List); ok {
-							// Check if it's an empty sequence (just "List" head)
-							if boundList.Length() == 0 {
-								// Empty sequence - skip adding anything
-								changed = true
-								continue
-							} else {
-								// Non-empty sequence - skip the "List" head and add the actual elements
-								newElements = append(newElements, boundList.Tail()...)
-								changed = true
-								continue
-							}
-						}
-					}
+			// a sequence variable as an argument is replaced by its
+			// elements, and by nothing if there are none
+			if i > 0 {
+				if seq, ok := sequenceBinding(elem, bindings); ok {
+					newElements = append(newElements, seq...)
+					changed = true
+					continue
 				}
 			}
 
-			// Regular substitution (not a sequence)
+			newElem := SubstituteBindings(elem, bindings)
 			newElements = append(newElements, newElem)
 			if !newElem.Equal(elem) {
 				changed = true
@@ -38,3 +38,18 @@ func AsSliceable(expr Expr) Sliceable {
 	}
 	return nil
 }
+
+// Keyed is implemented by expressions whose elements are looked up by key
+// rather than by position, such as Association
+type Keyed interface {
+	// ElementByKey returns the value for key, or Missing("KeyAbsent", key)
+	ElementByKey(key Expr) Expr
+}
+
+// AsKeyed safely casts an Expr to Keyed, returning nil if not keyed
+func AsKeyed(expr Expr) Keyed {
+	if keyed, ok := expr.(Keyed); ok {
+		return keyed
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/client9/cardinal/core/symbol"
@@ -16,10 +17,20 @@ func (s String) String() string {
 	return fmt.Sprintf("\"%s\"", string(s))
 }
 
+// InputForm quotes the string, escaping it so the parser reads back the
+// same string
 func (s String) InputForm() string {
-	return s.String()
+	return `"` + inputFormEscaper.Replace(string(s)) + `"`
 }
 
+var inputFormEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\t", `\t`,
+	"\r", `\r`,
+)
+
 func (s String) Head() Expr {
 	return symbol.String
 }
@@ -97,6 +97,7 @@ func PlusList(args []Expr) Expr {
 	} else if intsum.exists() {
 		total = intsum.Total()
 	}
+	total = ratToInteger(total)
 	if len(nonnum) == 0 || (total != nil && total.Sign() != 0) {
 		resultElements = append(resultElements, total)
 	}
@@ -117,9 +118,18 @@ func PlusList(args []Expr) Expr {
 	return NewListFromExprs(resultElements...)
 }
 
+// ratToInteger converts a Rational with denominator 1, such as the
+// result of 1/2 + 1/2, to an Integer
+func ratToInteger(total Number) Number {
+	if r, ok := total.(Rational); ok && r.IsInt() {
+		return r.AsNum().(Number)
+	}
+	return total
+}
+
 func TimesList(args []Expr) Expr {
 	intsum := AccumulatorInteger{
-		sum: newMachineInt(1),
+		sum: machineInt(1),
 	}
 	ratsum := AccumulatorRational{
 		sum: rat64One,
@@ -167,11 +177,11 @@ func TimesList(args []Expr) Expr {
 			if intsum.bigcount {
 				ratsum.TimesBigInt(&intsum.bigsum)
 			}
-			realsum.TimesFloat64(ratsum.Total().Float64())
+			realsum.TimesFloat64(ratsum.Product().Float64())
 		} else if intsum.exists() {
-			realsum.TimesFloat64(intsum.Total().Float64())
+			realsum.TimesFloat64(intsum.Product().Float64())
 		} else if ratsum.exists() {
-			realsum.TimesFloat64(ratsum.Total().Float64())
+			realsum.TimesFloat64(ratsum.Product().Float64())
 		}
 		if bigreal.exists() {
 			realsum.TimesFloat64(bigreal.Total().Float64())
@@ -183,10 +193,10 @@ func TimesList(args []Expr) Expr {
 		// don't have to worry about glue together int and rat
 		//  since they'll both be promoted to Big values anyways
 		if intsum.exists() {
-			bigreal.TimesInt(intsum.Total())
+			bigreal.TimesInt(intsum.Product())
 		}
 		if ratsum.exists() {
-			bigreal.TimesRat(ratsum.Total())
+			bigreal.TimesRat(ratsum.Product())
 		}
 
 		total = bigreal.Total()
@@ -197,21 +207,17 @@ func TimesList(args []Expr) Expr {
 		if intsum.bigcount {
 			ratsum.TimesBigInt(&intsum.bigsum)
 		}
-		total = ratsum.Total()
+		total = ratsum.Product()
 	} else if ratsum.exists() {
-		total = ratsum.Total()
+		total = ratsum.Product()
 	} else if intsum.exists() {
-		total = intsum.Total()
+		total = intsum.Product()
 	}
+	total = ratToInteger(total)
 	if total != nil {
 		if total.Sign() == 0 {
 			return NewInteger(0)
 		}
-		/*
-			if r, ok := total.(Rational); ok && r.IsInt() {
-				total = r.Num()
-			}
-		*/
 		if len(nonnum) == 0 {
 			// if we don't have any non-numerical arguments, add it
 			resultElements = append(resultElements, total)
@@ -267,7 +273,7 @@ func (a *AccumulatorInteger) exists() bool {
 func (a *AccumulatorInteger) Plus(b machineInt) {
 	if sumnext, ok := addInt64(a.sum.Int64(), b.Int64()); ok {
 		a.count = true
-		a.sum = newMachineInt(sumnext)
+		a.sum = machineInt(sumnext)
 		return
 	}
 	a.PlusBig(a.sum.AsBigInt())
@@ -288,12 +294,12 @@ func (a *AccumulatorInteger) TimesInt64(b machineInt) {
 	a.count = true
 	if prodnext, ok := timesInt64(a.sum.Int64(), b.Int64()); ok {
 		a.count = true
-		a.sum = newMachineInt(prodnext)
+		a.sum = machineInt(prodnext)
 		return
 	}
 	a.TimesBigInt(a.sum.AsBigInt())
 	a.TimesBigInt(b.AsBigInt())
-	a.sum = 0
+	a.sum = 1
 }
 
 func (a *AccumulatorInteger) TimesBigInt(b *big.Int) {
@@ -307,7 +313,7 @@ func (a *AccumulatorInteger) TimesBigInt(b *big.Int) {
 
 func (a *AccumulatorInteger) Total() Integer {
 	if !a.bigcount {
-		return a.sum
+		return newMachineInt(int64(a.sum))
 	}
 
 	if a.sum != 0 {
@@ -317,6 +323,17 @@ func (a *AccumulatorInteger) Total() Integer {
 	return &a.bigsum
 }
 
+// Product is Total for an accumulator used by Times
+func (a *AccumulatorInteger) Product() Integer {
+	if !a.bigcount {
+		return newMachineInt(int64(a.sum))
+	}
+	if a.sum != 1 {
+		a.bigsum.Mul(&a.bigsum, a.sum.AsBigInt())
+	}
+	return &a.bigsum
+}
+
 // Adds a series of integers
 type AccumulatorRational struct {
 	sum    rat64
@@ -360,9 +377,10 @@ func (a *AccumulatorRational) PlusBigInt(b *big.Int) {
 	a.bigsum.AddInt(&a.bigsum, b)
 }
 func (a *AccumulatorRational) PlusBigRat(b *big.Rat) {
-	if !a.count {
+	if !a.bigcount {
 		a.bigcount = true
 		a.bigsum.Set(b)
+		return
 	}
 	a.bigsum.Add(&a.bigsum, b)
 }
@@ -373,7 +391,9 @@ func (a *AccumulatorRational) TimesInt64(b machineInt) {
 		a.count = true
 		return
 	}
-	panic("Not implemented")
+	a.TimesBigRat(a.sum.AsBigRat())
+	a.TimesBigInt(b.AsBigInt())
+	a.sum = rat64One
 }
 
 func (a *AccumulatorRational) TimesRat64(b rat64) {
@@ -416,6 +436,15 @@ func (a *AccumulatorRational) Total() Rational {
 	return &a.bigsum
 }
 
+// Product is Total for an accumulator used by Times
+func (a *AccumulatorRational) Product() Rational {
+	if !a.bigcount {
+		return a.sum
+	}
+	a.bigsum.Mul(&a.bigsum, a.sum.AsBigRat())
+	return &a.bigsum
+}
+
 // Adds a series of floats
 type AccumulatorFloat64 struct {
 	sum   float64
@@ -26,6 +26,7 @@ const (
 	Orderless
 	Protected
 	ReadProtected
+	SequenceHold
 	Temporary
 	AttributeLast
 )
@@ -44,6 +45,7 @@ var symbolToAttribute = map[core.Symbol]Attribute{
 	symbol.NumericFunction: NumericFunction,
 	symbol.Protected:       Protected,
 	symbol.ReadProtected:   ReadProtected,
+	symbol.SequenceHold:    SequenceHold,
 	symbol.Locked:          Locked,
 	symbol.Temporary:       Temporary,
 	symbol.NHoldFirst:      NHoldFirst,
@@ -80,27 +82,35 @@ func AttributeToSymbols(a Attribute) []core.Expr {
 	return out
 }
 
-// SymbolTable manages attributes for symbols
+// SymbolTable manages attributes for symbols, and their default values
+// for optional arguments
 type SymbolTable struct {
 	attributes map[core.Symbol]Attribute
+	defaults   map[core.Symbol]core.Expr // see SetDefault
+	version    uint64                    // changes with the attributes and defaults, see Context.StateVersion
 }
 
 // NewSymbolTable creates a new symbol table instance
 func NewSymbolTable() *SymbolTable {
 	return &SymbolTable{
 		attributes: make(map[core.Symbol]Attribute),
+		defaults:   make(map[core.Symbol]core.Expr),
+		version:    nextStateVersion(),
 	}
 }
 
-// Reset clears all attributes from the symbol table (useful for testing)
+// Reset clears all attributes and defaults from the symbol table (useful for testing)
 func (st *SymbolTable) Reset() {
 	st.attributes = make(map[core.Symbol]Attribute)
+	st.defaults = make(map[core.Symbol]core.Expr)
+	st.version = nextStateVersion()
 }
 
 // SetAttributes sets one or more attributes for a symbol
 func (st *SymbolTable) SetAttributes(symbol core.Symbol, attrs Attribute) {
 	alist := st.attributes[symbol]
 	st.attributes[symbol] = alist | attrs
+	st.version = nextStateVersion()
 }
 
 // ClearAttributes removes one or more attributes from a symbol
@@ -110,6 +120,7 @@ func (st *SymbolTable) ClearAttributes(symbol core.Symbol, attrs Attribute) {
 		return
 	}
 	alist &^= attrs
+	st.version = nextStateVersion()
 	if alist == 0 {
 		delete(st.attributes, symbol)
 		return
@@ -131,6 +142,27 @@ func (st *SymbolTable) HasAttribute(symbol core.Symbol, attr Attribute) bool {
 // ClearAllAttributes removes all attributes from a symbol
 func (st *SymbolTable) ClearAllAttributes(symbol core.Symbol) {
 	delete(st.attributes, symbol)
+	st.version = nextStateVersion()
+}
+
+// SetDefault sets the value an omitted x_. argument of symbol takes
+func (st *SymbolTable) SetDefault(symbol core.Symbol, value core.Expr) {
+	st.defaults[symbol] = value
+	st.version = nextStateVersion()
+}
+
+// Default returns the value set by SetDefault, if any
+func (st *SymbolTable) Default(symbol core.Symbol) (core.Expr, bool) {
+	value, ok := st.defaults[symbol]
+	return value, ok
+}
+
+// ClearDefault removes the default of a symbol
+func (st *SymbolTable) ClearDefault(symbol core.Symbol) {
+	if _, ok := st.defaults[symbol]; ok {
+		delete(st.defaults, symbol)
+		st.version = nextStateVersion()
+	}
 }
 
 // AllSymbolsWithAttributes returns all symbols that have attributes
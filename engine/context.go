@@ -1,7 +1,10 @@
 package engine
 
 import (
+	"errors"
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/client9/cardinal/core"
 )
@@ -23,7 +26,7 @@ func NewEvaluationStack(maxDepth int) *EvaluationStack {
 // Push adds a new frame to the stack and checks for recursion limits
 func (s *EvaluationStack) Push(function string, expression core.Expr) error {
 	if s.depth >= s.maxDepth {
-		return fmt.Errorf("maximum recursion depth exceeded: %d", s.maxDepth)
+		return fmt.Errorf("recursion limit of %d exceeded, see $RecursionLimit", s.maxDepth)
 	}
 	s.depth++
 	return nil
@@ -41,14 +44,55 @@ func (s *EvaluationStack) Depth() int {
 	return s.depth
 }
 
+// The limits of a Context are read and written as these symbols
+var (
+	recursionLimitSymbol = core.NewSymbol("$RecursionLimit")
+	iterationLimitSymbol = core.NewSymbol("$IterationLimit")
+)
+
+// ErrLimitValue is returned by Set for a value that can't be a limit,
+// such as $IterationLimit = "a"
+var ErrLimitValue = errors.New("invalid limit")
+
+// maxRecursionLimit bounds $RecursionLimit so that deep recursion ends in
+// a RecursionError well before the Go stack overflows, which kills the
+// process
+const maxRecursionLimit = 50000
+
 // Context represents the evaluation context with variable bindings and symbol attributes
 type Context struct {
 	variables        map[core.Symbol]core.Expr
 	symbolTable      *SymbolTable
 	functionRegistry *FunctionRegistry // Unified pattern-based function system
 	stack            *EvaluationStack
+	iterationLimit   int       // maximum iterations of a While or For loop
+	deadline         time.Time // evaluation stops after this, see Evaluator.EvaluateWithin
+	messages         []string
+	errorCount       int64       // errors produced so far, see Check
+	outputs          []core.Expr // the most recent results, see AddOutput
+	outputLine       int64       // number of the last result
+	version          uint64      // changes to the variables and outputs, see StateVersion
+}
+
+// stateVersions numbers every change to the variables, definitions and
+// attributes of any Context, so a version is never used twice, even by
+// another Evaluator
+var stateVersions atomic.Uint64
+
+func nextStateVersion() uint64 {
+	return stateVersions.Add(1)
+}
+
+// StateVersion changes whenever a variable, definition, attribute or
+// output of the Context changes. An expression that evaluated to itself
+// does so again while the version is the same, see core.List.MarkEvaluated.
+func (c *Context) StateVersion() uint64 {
+	return max(c.version, c.symbolTable.version, c.functionRegistry.version)
 }
 
+// maxOutputs is how many results are kept for Out and %
+const maxOutputs = 100
+
 // NewContext creates a new evaluation context
 func NewContext() *Context {
 	ctx := &Context{
@@ -56,14 +100,45 @@ func NewContext() *Context {
 		symbolTable:      NewSymbolTable(),
 		functionRegistry: NewFunctionRegistry(),
 		stack:            NewEvaluationStack(1000), // Default max depth of 1000
+		iterationLimit:   10000,
+		version:          nextStateVersion(),
 	}
 
 	return ctx
 }
 
+// Clear removes the value and definitions of a symbol, but keeps its
+// attributes
 func (c *Context) Clear(name core.Symbol) {
 	delete(c.variables, name)
-	c.functionRegistry.Clear(name)
+	c.version = nextStateVersion()
+	c.functionRegistry.ClearFunction(name)
+}
+
+// ClearAll removes the value, definitions, attributes and default of a
+// symbol
+func (c *Context) ClearAll(name core.Symbol) {
+	c.Clear(name)
+	c.symbolTable.ClearAllAttributes(name)
+	c.symbolTable.ClearDefault(name)
+}
+
+// WalkState calls fn with every value of a variable and every pattern and
+// body of a user definition, together with the symbol it belongs to
+func (c *Context) WalkState(fn func(name core.Symbol, expr core.Expr)) {
+	for name, value := range c.variables {
+		fn(name, value)
+	}
+	for _, defs := range []map[core.Symbol][]FunctionDef{c.functionRegistry.functions, c.functionRegistry.upValues} {
+		for name, list := range defs {
+			for _, def := range list {
+				if !def.IsBuiltin {
+					fn(name, def.Pattern)
+					fn(name, def.Body)
+				}
+			}
+		}
+	}
 }
 
 // GetFunctionDefinitions returns a list of patterns registered to the given symbol
@@ -80,13 +155,37 @@ func (c *Context) Set(name core.Symbol, value core.Expr) error {
 	if c.symbolTable.HasAttribute(name, Protected) {
 		return fmt.Errorf("symbol %s is Protected", name)
 	}
+	// $RecursionLimit and $IterationLimit change the context's limits
+	switch name {
+	case recursionLimitSymbol, iterationLimitSymbol:
+		n, ok := core.ExtractInt64(value)
+		if !ok || n < 0 || (n == 0 && name == recursionLimitSymbol) {
+			return fmt.Errorf("%w: %s must be a non-negative integer", ErrLimitValue, name)
+		}
+		if name == recursionLimitSymbol {
+			if n > maxRecursionLimit {
+				return fmt.Errorf("%w: %s must be at most %d", ErrLimitValue, name, maxRecursionLimit)
+			}
+			c.SetRecursionLimit(int(n))
+		} else {
+			c.SetIterationLimit(int(n))
+		}
+		return nil
+	}
 	// Otherwise set in current context (root context or explicitly local)
 	c.variables[name] = value
+	c.version = nextStateVersion()
 	return nil
 }
 
 // Get retrieves a variable from the context (searches up the parent chain)
 func (c *Context) Get(name core.Symbol) (core.Expr, bool) {
+	switch name {
+	case recursionLimitSymbol:
+		return core.NewInteger(int64(c.RecursionLimit())), true
+	case iterationLimitSymbol:
+		return core.NewInteger(int64(c.IterationLimit())), true
+	}
 	if value, ok := c.variables[name]; ok {
 		return value, true
 	}
@@ -99,9 +198,97 @@ func (c *Context) Delete(name core.Symbol) error {
 		return fmt.Errorf("symbol %s is Protected", name)
 	}
 	delete(c.variables, name)
+	c.version = nextStateVersion()
 	return nil
 }
 
+// SetRecursionLimit sets the maximum depth of nested evaluations before
+// a RecursionError is returned
+func (c *Context) SetRecursionLimit(n int) {
+	c.stack.maxDepth = n
+}
+
+// RecursionLimit returns the maximum depth of nested evaluations
+func (c *Context) RecursionLimit() int {
+	return c.stack.maxDepth
+}
+
+// SetIterationLimit sets the maximum number of iterations a loop may run
+// before it returns an IterationLimitError.  Zero means no limit.
+func (c *Context) SetIterationLimit(n int) {
+	c.iterationLimit = n
+}
+
+// IterationLimit returns the maximum number of iterations of a loop
+func (c *Context) IterationLimit() int {
+	return c.iterationLimit
+}
+
+// Deadline returns the time the current evaluation must finish by, set
+// by TimeConstrained. ok is false if there is no deadline.
+func (c *Context) Deadline() (deadline time.Time, ok bool) {
+	return c.deadline, !c.deadline.IsZero()
+}
+
+// pastDeadline reports whether the deadline, if any, has passed
+func (c *Context) pastDeadline() bool {
+	return !c.deadline.IsZero() && time.Now().After(c.deadline)
+}
+
+// AddMessage records a diagnostic from Message
+func (c *Context) AddMessage(msg string) {
+	c.messages = append(c.messages, msg)
+}
+
+// Messages returns the diagnostics recorded since the last ClearMessages
+func (c *Context) Messages() []string {
+	return c.messages
+}
+
+// ClearMessages discards the recorded diagnostics
+func (c *Context) ClearMessages() {
+	c.messages = nil
+}
+
+// TruncateMessages discards the diagnostics recorded after the first n
+func (c *Context) TruncateMessages(n int) {
+	if n < len(c.messages) {
+		c.messages = c.messages[:n]
+	}
+}
+
+// ErrorCount returns the number of errors produced by evaluation so
+// far.  An error is counted once, where it is created.
+func (c *Context) ErrorCount() int64 {
+	return c.errorCount
+}
+
+// AddOutput records the result of a top-level evaluation, such as a
+// REPL input, so Out and % can refer to it.  Results are numbered from
+// 1 and only the most recent are kept.  It returns the result's number.
+func (c *Context) AddOutput(result core.Expr) int64 {
+	c.outputLine++
+	c.outputs = append(c.outputs, result)
+	c.version = nextStateVersion()
+	if len(c.outputs) > maxOutputs {
+		c.outputs = c.outputs[len(c.outputs)-maxOutputs:]
+	}
+	return c.outputLine
+}
+
+// Output returns result number n.  A negative n counts back from the
+// last result, so -1 is the last one.
+func (c *Context) Output(n int64) (core.Expr, bool) {
+	if n < 0 {
+		n += c.outputLine + 1
+	}
+	i := int64(len(c.outputs)) - (c.outputLine - n) - 1
+	if n < 1 || n > c.outputLine || i < 0 {
+		return nil, false
+	}
+	return c.outputs[i], true
+}
+
 // GetFunctionRegistry returns the context's function registry
 func (c *Context) GetFunctionRegistry() *FunctionRegistry {
 	return c.functionRegistry
@@ -2,7 +2,9 @@ package engine
 
 import (
 	"fmt"
+	"slices"
 	"sort"
+	"time"
 	//	"log"
 
 	"github.com/client9/cardinal/core"
@@ -11,14 +13,151 @@ import (
 
 // Evaluator represents the expression evaluator
 type Evaluator struct {
-	context *Context
+	context   *Context
+	operators *core.OperatorTable
+	safeMode  bool
+	allowExec bool
+	errorHook func(core.ErrorExpr)
+	stepLimit int64 // maximum steps per evaluation, 0 for no limit
+	steps     int64 // steps taken by the current evaluation
+
+	moduleNumber int64 // counter for UniqueSymbol
+
+	tracer *tracer // records the evaluation when not nil, see Trace
 }
 
 // NewEvaluator creates a new evaluator with a fresh context
 func NewEvaluator() *Evaluator {
 	return &Evaluator{
-		context: NewContext(),
+		context:   NewContext(),
+		operators: core.NewOperatorTable(),
+	}
+}
+
+// Operators returns the user-defined infix operators used by ParseString
+func (e *Evaluator) Operators() *core.OperatorTable {
+	return e.operators
+}
+
+// ParseString parses input using this evaluator's user-defined operators
+func (e *Evaluator) ParseString(input string) (core.Expr, error) {
+	return core.ParseStringWithOperators(input, e.operators)
+}
+
+// EvaluateString parses and evaluates input. A parse error is returned
+// as an error; an evaluation error is an ErrorExpr result.
+func (e *Evaluator) EvaluateString(input string) (core.Expr, error) {
+	expr, err := e.ParseString(input)
+	if err != nil {
+		return nil, err
 	}
+	return e.Evaluate(expr), nil
+}
+
+// EvaluateAll parses and evaluates a program of one or more statements,
+// separated by semicolons or newlines, and returns the result of the
+// last. Evaluation stops at the first statement that gives an error, and
+// that error is the result. An empty program is Null.
+func (e *Evaluator) EvaluateAll(input string) (core.Expr, error) {
+	exprs, err := core.ParseAllWithOperators(input, e.operators)
+	if err != nil {
+		return nil, err
+	}
+	var result core.Expr = symbol.Null
+	for _, expr := range exprs {
+		result = e.Evaluate(expr)
+		if core.IsError(result) {
+			break
+		}
+	}
+	return result, nil
+}
+
+// SetSafeMode turns safe mode on or off.  In safe mode builtins that
+// access the environment or operating system return a SecurityError.
+func (e *Evaluator) SetSafeMode(on bool) {
+	e.safeMode = on
+}
+
+// SafeMode reports whether safe mode is on
+func (e *Evaluator) SafeMode() bool {
+	return e.safeMode
+}
+
+// SetAllowExec allows or forbids running external processes with
+// RunProcess.  It is off by default, and has no effect in safe mode.
+func (e *Evaluator) SetAllowExec(on bool) {
+	e.allowExec = on
+}
+
+// AllowExec reports whether external processes may be run
+func (e *Evaluator) AllowExec() bool {
+	return e.allowExec && !e.safeMode
+}
+
+// SetCommandLine sets $CommandLine to the list of args.  It is not set in
+// safe mode.
+func (e *Evaluator) SetCommandLine(args []string) {
+	if e.safeMode {
+		return
+	}
+	name := core.NewSymbol("$CommandLine")
+	list := make([]core.Expr, len(args))
+	for i, arg := range args {
+		list[i] = core.NewString(arg)
+	}
+	st := e.context.GetSymbolTable()
+	st.ClearAttributes(name, Protected)
+	e.context.Set(name, core.ListFrom(symbol.List, list...))
+	st.SetAttributes(name, Protected)
+}
+
+// SetErrorHook sets a function that is called with each error produced
+// during evaluation, for logging or monitoring.  Errors are reported once,
+// where they are created, not again as they propagate.  A nil hook turns
+// reporting off.
+func (e *Evaluator) SetErrorHook(hook func(core.ErrorExpr)) {
+	e.errorHook = hook
+}
+
+// reportError counts result if it is a newly created error, and calls
+// the error hook
+func (e *Evaluator) reportError(result core.Expr) {
+	if err, ok := core.AsError(result); ok && err.Err == nil {
+		// a Throw is control flow, not an error
+		if _, _, thrown := err.AsThrow(); thrown {
+			return
+		}
+		e.context.errorCount++
+		if e.errorHook != nil {
+			e.errorHook(err)
+		}
+	}
+}
+
+// SetStepLimit limits the number of reduction steps a single call to
+// Evaluate may take.  Once exceeded, evaluation stops with a StepLimitError.
+// A limit of 0 means no limit.
+func (e *Evaluator) SetStepLimit(n int64) {
+	e.stepLimit = n
+}
+
+// StepLimit returns the current step limit, 0 if there is none
+func (e *Evaluator) StepLimit() int64 {
+	return e.stepLimit
+}
+
+// Steps returns the number of reduction steps taken by the most recent
+// top-level evaluation.  Steps are only counted when a limit is set.
+func (e *Evaluator) Steps() int64 {
+	return e.steps
+}
+
+// UniqueSymbol returns a new symbol name$n, where n is different for
+// every call.  Module uses it to rename its local variables.
+func (e *Evaluator) UniqueSymbol(name string) core.Symbol {
+	e.moduleNumber++
+	return core.NewSymbol(fmt.Sprintf("%s$%d", name, e.moduleNumber))
 }
 
 // GetContext returns the evaluator's current context
@@ -29,24 +168,100 @@ func (e *Evaluator) GetContext() *Context {
 // Evaluate evaluates an expression in the current context
 func (e *Evaluator) Evaluate(expr core.Expr) core.Expr {
 	ctx := e.context
+	top := ctx.stack.Depth() == 0
+	if top {
+		// a new top-level evaluation gets a fresh step budget
+		e.steps = 0
+	}
+	switch ex := expr.(type) {
+	case core.Symbol, core.ErrorExpr:
+	case core.List:
+		if e.tracer == nil && ex.IsEvaluated(ctx.StateVersion()) {
+			// nothing changed since it last evaluated to itself
+			return ex
+		}
+	default:
+		// numbers, strings and the other atoms evaluate to themselves
+		return expr
+	}
+	if e.tracer != nil && !e.tracer.splice && isTraced(expr) {
+		e.tracer.push(expr)
+		defer e.tracer.pop()
+	}
+	if e.tracer != nil {
+		e.tracer.splice = false
+	}
+	if ctx.pastDeadline() {
+		result := core.NewError("TimeLimitError", "time limit exceeded").SetCaller(expr)
+		e.reportError(result)
+		return result
+	}
 	if err := ctx.stack.Push("evaluate", expr); err != nil {
-		return core.NewError("RecursionError", err.Error()).SetCaller(expr)
+		result := core.NewError("RecursionError", err.Error()).SetCaller(expr)
+		e.reportError(result)
+		return result
 	}
 	defer ctx.stack.Pop()
 	result := e.evaluateToFixedPoint(e.context, expr)
 	if err, ok := core.AsError(result); ok {
 		return err.Wrap(expr)
 	}
+	if top && e.stepLimit > 0 && e.steps > e.stepLimit {
+		// a builtin ignored the StepLimitError, such as a loop treating
+		// it as a False test, so don't return a partial result
+		return core.NewError("StepLimitError",
+			fmt.Sprintf("step limit of %d exceeded", e.stepLimit)).SetCaller(expr)
+	}
 	return result
 }
 
+// EvaluateWithin evaluates expr, stopping with a TimeLimitError once d
+// has passed, and reports whether it ran out of time.  An enclosing
+// deadline that is earlier still applies.
+func (e *Evaluator) EvaluateWithin(expr core.Expr, d time.Duration) (core.Expr, bool) {
+	deadline := time.Now().Add(d)
+	c := e.context
+	outer := c.deadline
+	if outer.IsZero() || deadline.Before(outer) {
+		c.deadline = deadline
+	}
+	defer func() { c.deadline = outer }()
+
+	result := e.Evaluate(expr)
+	// a builtin may have ignored the TimeLimitError and returned a
+	// partial result
+	return result, time.Now().After(deadline)
+}
+
+// Match matches expr against pattern with the same matcher used for
+// function dispatch, so Condition and PatternTest are evaluated and an
+// x_. argument of f takes the value of Default(f). The pattern is
+// compiled once, see core.CompilePattern, so calling Match in a loop
+// with the same pattern is cheap.
+func (e *Evaluator) Match(expr, pattern core.Expr) (bool, core.PatternBindings) {
+	if needsDefaults(pattern) {
+		pattern, _ = fillDefaults(pattern, e.context.symbolTable)
+	}
+	return core.CompilePattern(pattern).Match(expr, e.testTrue)
+}
+
+// testTrue evaluates expr and reports whether the result is True.
+// It is the core.TestFunc used for Condition checks during matching.
+func (e *Evaluator) testTrue(expr core.Expr) bool {
+	return e.Evaluate(expr) == symbol.True
+}
+
 // evaluateToFixedPoint continues evaluating an expression until it reaches a fixed point
 // (no more changes occur) or until a maximum number of iterations to prevent infinite loops
 func (e *Evaluator) evaluateToFixedPoint(ctx *Context, expr core.Expr) core.Expr {
+	version := ctx.StateVersion()
 	next := e.evaluateExpr(ctx, expr)
 	if core.IsError(next) {
 		return next
 	}
+	if e.tracer != nil && isTraced(expr) && !next.Equal(expr) {
+		e.tracer.record(next)
+	}
 
 	// If the result is atomic, we can't evaluate further
 	if next.IsAtom() {
@@ -54,12 +269,39 @@ func (e *Evaluator) evaluateToFixedPoint(ctx *Context, expr core.Expr) core.Expr
 	}
 	// Check if we've reached a fixed point (no more changes)
 	if next.Equal(expr) {
+		// it evaluates to itself again until the state changes, unless
+		// a limit cut the evaluation short
+		if list, ok := next.(core.List); ok && ctx.StateVersion() == version && e.withinLimits() {
+			list.MarkEvaluated(version)
+		}
 		return next
 	}
 
+	if e.tracer != nil {
+		// the rewrite is part of the same trace
+		e.tracer.splice = true
+	}
 	return e.Evaluate(next)
 }
 
+// withinLimits reports whether the current evaluation has not run out of
+// steps or time
+func (e *Evaluator) withinLimits() bool {
+	if e.stepLimit > 0 && e.steps > e.stepLimit {
+		return false
+	}
+	return !e.context.pastDeadline()
+}
+
+// isTraced reports whether the evaluation of expr is recorded by Trace
+func isTraced(expr core.Expr) bool {
+	switch expr.(type) {
+	case core.Symbol, core.List:
+		return true
+	}
+	return false
+}
+
 func (e *Evaluator) evaluateExpr(ctx *Context, expr core.Expr) core.Expr {
 	switch ex := expr.(type) {
 	case core.Symbol:
@@ -70,6 +312,15 @@ func (e *Evaluator) evaluateExpr(ctx *Context, expr core.Expr) core.Expr {
 		// Return the symbol itself if not bound
 		return ex
 	case core.List:
+		if e.stepLimit > 0 {
+			e.steps++
+			if e.steps > e.stepLimit {
+				result := core.NewError("StepLimitError",
+					fmt.Sprintf("step limit of %d exceeded", e.stepLimit)).SetCaller(expr)
+				e.reportError(result)
+				return result
+			}
+		}
 		result := e.evaluateList(ctx, ex)
 
 		// downstream doesn't have access to the original
@@ -95,8 +346,9 @@ func (e *Evaluator) evaluateList(c *Context, list core.List) core.Expr {
 
 	// Evaluate the head to get the function name
 	evaluatedHead := e.Evaluate(head)
-	if _, ok := core.AsError(evaluatedHead); ok {
-		return list
+	if core.IsError(evaluatedHead) {
+		// such as a RecursionError, which must not be swallowed
+		return evaluatedHead
 	}
 
 	// Check if head is a function expression (function application)
@@ -112,9 +364,9 @@ func (e *Evaluator) evaluateList(c *Context, list core.List) core.Expr {
 	}
 
 	// Apply attribute transformations before evaluation
-	transformedList := e.applyAttributeTransformations(headName, list, c)
+	transformedList, changed := e.applyAttributeTransformations(headName, list, c)
 
-	if !transformedList.Equal(list) {
+	if changed {
 		// The list was transformed, re-evaluate it
 		return e.evaluateList(c, transformedList)
 	}
@@ -138,7 +390,8 @@ func (e *Evaluator) evaluateList(c *Context, list core.List) core.Expr {
 func (e *Evaluator) evaluatePatternFunction(headName core.Symbol, args []core.Expr, ctx *Context) core.Expr {
 
 	// Evaluate arguments based on hold attributes
-	evaluatedArgs := e.evaluateArguments(headName, args, ctx)
+	holds := e.argumentHolds(headName, ctx)
+	evaluatedArgs := e.evaluateArguments(holds, args)
 
 	// Check for errors in evaluated arguments
 	for _, arg := range evaluatedArgs {
@@ -147,30 +400,82 @@ func (e *Evaluator) evaluatePatternFunction(headName core.Symbol, args []core.Ex
 		}
 	}
 
+	// Sequences in the evaluated arguments are spliced into the call
+	spliced := func(i int) bool {
+		if holds.sequence {
+			return false
+		}
+		if _, ok := unevaluated(args[i]); ok {
+			return false
+		}
+		_, forced := forcedEvaluation(args[i])
+		return forced || !holds.held(i)
+	}
+
 	// Create the function call expression for pattern matching
-	callExpr := core.ListFrom(headName, evaluatedArgs...)
+	callExpr := core.ListFrom(headName, spliceSequences(evaluatedArgs, spliced)...)
+	if e.tracer != nil && !callExpr.Equal(core.ListFrom(headName, args...)) {
+		e.tracer.record(callExpr)
+	}
 
 	// Try to find a matching pattern in the function registry
 	if result, found := ctx.functionRegistry.CallFunction(callExpr, ctx, e); found {
+		e.reportError(result)
 		return result
 	}
 
-	// No pattern matched, return the unevaluated expression
+	// Then definitions attached to the heads of the arguments
+	if result, found := ctx.functionRegistry.CallUpValue(callExpr, ctx, e); found {
+		return result
+	}
+
+	// No pattern matched, return the unevaluated expression, keeping
+	// any Unevaluated wrappers
+	for i, arg := range args {
+		if _, ok := unevaluated(arg); ok {
+			evaluatedArgs[i] = arg
+			callExpr = core.ListFrom(headName, spliceSequences(evaluatedArgs, spliced)...)
+		}
+	}
 	return callExpr
 }
 
-// evaluateArguments evaluates arguments based on hold attributes
-func (e *Evaluator) evaluateArguments(headName core.Symbol, args []core.Expr, ctx *Context) []core.Expr {
-	evaluatedArgs := make([]core.Expr, len(args))
+// argumentHolds records which arguments of a function are held, and
+// whether Sequence arguments are kept whole
+type argumentHolds struct {
+	all, first, rest bool
+	sequence         bool
+}
 
+// argumentHolds returns the hold attributes of headName
+func (e *Evaluator) argumentHolds(headName core.Symbol, ctx *Context) argumentHolds {
 	// TODO -- one lookup
-	holdAll := ctx.symbolTable.HasAttribute(headName, HoldAll)
-	holdFirst := ctx.symbolTable.HasAttribute(headName, HoldFirst)
-	holdRest := ctx.symbolTable.HasAttribute(headName, HoldRest)
+	return argumentHolds{
+		all:      ctx.symbolTable.HasAttribute(headName, HoldAll),
+		first:    ctx.symbolTable.HasAttribute(headName, HoldFirst),
+		rest:     ctx.symbolTable.HasAttribute(headName, HoldRest),
+		sequence: ctx.symbolTable.HasAttribute(headName, SequenceHold),
+	}
+}
+
+// held reports whether argument i is held
+func (h argumentHolds) held(i int) bool {
+	return h.all || (h.first && i == 0) || (h.rest && i > 0)
+}
+
+// evaluateArguments evaluates arguments based on hold attributes
+func (e *Evaluator) evaluateArguments(holds argumentHolds, args []core.Expr) []core.Expr {
+	evaluatedArgs := make([]core.Expr, len(args))
 
 	for i, arg := range args {
-		if holdAll || (holdFirst && i == 0) || (holdRest && i > 0) {
-			evaluatedArgs[i] = arg // Don't evaluate
+		if holds.held(i) {
+			if inner, ok := forcedEvaluation(arg); ok {
+				evaluatedArgs[i] = e.Evaluate(inner)
+			} else {
+				evaluatedArgs[i] = arg // Don't evaluate
+			}
+		} else if inner, ok := unevaluated(arg); ok {
+			evaluatedArgs[i] = inner
 		} else {
 			evaluatedArgs[i] = e.Evaluate(arg)
 		}
@@ -179,18 +484,69 @@ func (e *Evaluator) evaluateArguments(headName core.Symbol, args []core.Expr, ct
 	return evaluatedArgs
 }
 
-// applyAttributeTransformations applies attribute-based transformations
-func (e *Evaluator) applyAttributeTransformations(headName core.Symbol, list core.List, ctx *Context) core.List {
+// spliceSequences replaces each argument Sequence(a, b, ...) for which
+// spliced(i) is true by a, b, ...: f(a, Sequence(b, c), d) → f(a, b, c, d).
+// args is returned as is if there is nothing to splice.
+func spliceSequences(args []core.Expr, spliced func(i int) bool) []core.Expr {
+	var out []core.Expr
+	for i, arg := range args {
+		if seq, ok := arg.(core.List); ok && seq.Head() == symbol.Sequence && spliced(i) {
+			if out == nil {
+				out = make([]core.Expr, i, len(args)+int(seq.Length()))
+				copy(out, args[:i])
+			}
+			out = append(out, seq.Tail()...)
+			continue
+		}
+		if out != nil {
+			out = append(out, arg)
+		}
+	}
+	if out == nil {
+		return args
+	}
+	return out
+}
+
+// forcedEvaluation returns expr from Evaluate(expr), which is evaluated
+// even as a held argument
+func forcedEvaluation(arg core.Expr) (core.Expr, bool) {
+	list, ok := arg.(core.List)
+	if !ok || list.Head() != symbol.Evaluate || list.Length() != 1 {
+		return nil, false
+	}
+	return list.Tail()[0], true
+}
+
+// unevaluated returns expr from Unevaluated(expr), which is passed to
+// a function as is
+func unevaluated(arg core.Expr) (core.Expr, bool) {
+	list, ok := arg.(core.List)
+	if !ok || list.Head() != symbol.Unevaluated || list.Length() != 1 {
+		return nil, false
+	}
+	return list.Tail()[0], true
+}
+
+// applyAttributeTransformations applies attribute-based transformations,
+// and reports whether they changed the list. An unchanged list is
+// returned as is.
+func (e *Evaluator) applyAttributeTransformations(headName core.Symbol, list core.List, ctx *Context) (core.List, bool) {
 	result := list
+	changed := false
 
 	// Apply Flat attribute (associativity)
 	if ctx.symbolTable.HasAttribute(headName, Flat) {
-		result = e.applyFlat(headName, result)
+		var flattened bool
+		result, flattened = e.applyFlat(headName, result)
+		changed = changed || flattened
 	}
 
 	// Apply Orderless attribute (commutativity)
 	if ctx.symbolTable.HasAttribute(headName, Orderless) {
-		result = e.applyOrderless(result)
+		var sorted bool
+		result, sorted = e.applyOrderless(result)
+		changed = changed || sorted
 	}
 
 	// Apply OneIdentity attribute
@@ -198,40 +554,54 @@ func (e *Evaluator) applyAttributeTransformations(headName core.Symbol, list cor
 		result = e.applyOneIdentity(result)
 	}
 
-	return result
+	return result, changed
 }
 
-// applyFlat implements the Flat attribute (associativity)
-func (e *Evaluator) applyFlat(head core.Symbol, list core.List) core.List {
-	if list.Length() == 0 {
-		return list
-	}
-
+// applyFlat implements the Flat attribute (associativity), reporting
+// whether any argument was flattened
+func (e *Evaluator) applyFlat(head core.Symbol, list core.List) (core.List, bool) {
 	listhead := list.Head()
 	args := list.Tail()
 
-	newArgs := []core.Expr{}
-
+	// most calls have nothing to flatten
+	nested := false
 	for _, arg := range args {
-		// If the argument is the same function, flatten it
-		if argList, ok := arg.(core.List); ok {
-			if argList.Head() == listhead {
-				// Flatten: f(a, f(b, c), d) → f(a, b, c, d)
-				newArgs = append(newArgs, argList.Tail()...)
-				continue
-			}
+		if argList, ok := arg.(core.List); ok && argList.Head() == listhead {
+			nested = true
+			break
 		}
-		newArgs = append(newArgs, arg)
+	}
+	if !nested {
+		return list, false
 	}
 
-	return core.ListFrom(head, newArgs...)
+	elements := make([]core.Expr, 1, len(args)+2)
+	elements[0] = head
+	elements = appendFlat(elements, listhead, args)
+	return core.NewListFromExprs(elements...), true
 }
 
-// applyOrderless implements the Orderless attribute (commutativity)
-func (e *Evaluator) applyOrderless(list core.List) core.List {
-	if list.Length() < 2 {
-		// Not enough elements to sort (need at least head + 2 args)
-		return list
+// appendFlat appends args to dst, replacing each argument with the same
+// head by its own arguments, flattened in turn:
+// f(a, f(b, f(c)), d) → f(a, b, c, d)
+func appendFlat(dst []core.Expr, head core.Expr, args []core.Expr) []core.Expr {
+	for _, arg := range args {
+		if argList, ok := arg.(core.List); ok && argList.Head() == head {
+			dst = appendFlat(dst, head, argList.Tail())
+			continue
+		}
+		dst = append(dst, arg)
+	}
+	return dst
+}
+
+// applyOrderless implements the Orderless attribute (commutativity),
+// reporting whether the arguments had to be sorted
+func (e *Evaluator) applyOrderless(list core.List) (core.List, bool) {
+	// Arguments already in order are the common case, e.g. the result
+	// of an earlier evaluation
+	if core.InCanonicalOrder(list.Tail()) {
+		return list, false
 	}
 
 	head := list.Head()
@@ -248,7 +618,7 @@ func (e *Evaluator) applyOrderless(list core.List) core.List {
 	resultElements[0] = head
 	copy(resultElements[1:], args)
 
-	return core.NewListFromExprs(resultElements...)
+	return core.NewListFromExprs(resultElements...), true
 }
 
 // applyOneIdentity implements the OneIdentity attribute
@@ -282,29 +652,37 @@ func (e *Evaluator) applyFunction(c *Context, funcExpr core.FunctionExpr, args [
 			return evaluatedArgs[i]
 		}
 	}
+	evaluatedArgs = spliceSequences(evaluatedArgs, func(int) bool { return true })
+	args = evaluatedArgs
 
-	rules := make([]core.Expr, len(args))
+	var rules []core.Expr
+	body := funcExpr.Body
 
 	if funcExpr.Parameters == nil {
 		// Anonymous
+		// $$ is the sequence of all arguments, spliced into its enclosing call
+		var seq core.PatternBindings
+		seq.AddSequence("$$", evaluatedArgs)
+		body = core.SubstituteBindings(body, seq)
+
 		for i := 0; i < len(args); i++ {
 			name := core.NewSymbol(fmt.Sprintf("$%d", i+1))
-			rules[i] = core.ListFrom(symbol.Rule, name, evaluatedArgs[i])
+			rules = append(rules, core.ListFrom(symbol.Rule, name, evaluatedArgs[i]))
 		}
 		if len(args) > 0 {
 			name := core.NewSymbol("$")
 			rules = append(rules, core.ListFrom(symbol.Rule, name, evaluatedArgs[0]))
 		}
 	} else {
-		// Named - Check argument count
-		if len(args) != len(funcExpr.Parameters) {
+		// Named - Check argument count, extra arguments are ignored
+		if len(args) < len(funcExpr.Parameters) {
 			return core.NewError(
 				"ArgumentError",
 				fmt.Sprintf("Function expects %d arguments, got %d",
 					len(funcExpr.Parameters), len(args)))
 		}
-		for i := 0; i < len(args); i++ {
-			rules[i] = core.ListFrom(symbol.Rule, funcExpr.Parameters[i], evaluatedArgs[i])
+		for i, param := range funcExpr.Parameters {
+			rules = append(rules, core.ListFrom(symbol.Rule, param, evaluatedArgs[i]))
 		}
 	}
 
@@ -312,7 +690,7 @@ func (e *Evaluator) applyFunction(c *Context, funcExpr core.FunctionExpr, args [
 
 	rlist := core.NewList(symbol.List, rules...)
 
-	modified := functionReplaceAll(e, c, funcExpr.Body, rlist)
+	modified := functionReplaceAll(e, c, body, rlist)
 
 	result := e.Evaluate(modified)
 	return result
@@ -433,9 +811,48 @@ func (e *Evaluator) evaluateSliceSet(args []core.Expr, ctx *Context) core.Expr {
 	return sliceable.SetSlice(start, end, value)
 }
 
+// unshadowedRules returns the rules for names that are not parameters of
+// fn, or slots if fn is a pure function
+func unshadowedRules(fn core.FunctionExpr, rules core.Expr) core.Expr {
+	var out []core.Expr
+	for _, rule := range rules.(core.List).Tail() {
+		name, _ := rule.(core.List).Tail()[0].(core.Symbol)
+		if fn.Parameters == nil && isSlot(name) {
+			continue
+		}
+		if slices.ContainsFunc(fn.Parameters, name.Equal) {
+			continue
+		}
+		out = append(out, rule)
+	}
+	return core.ListFrom(symbol.List, out...)
+}
+
+// isSlot reports whether name is $, $$ or a numbered slot such as $2
+func isSlot(name core.Symbol) bool {
+	s := name.String()
+	if s == "$" || s == "$$" {
+		return true
+	}
+	if len(s) < 2 || s[0] != '$' {
+		return false
+	}
+	for _, ch := range s[1:] {
+		if ch < '0' || ch > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 func functionReplaceAll(e *Evaluator, c *Context, expr core.Expr, rule core.Expr) core.Expr {
 	//log.Printf("rules %v , body %v", rule, expr)
 	if fn, ok := expr.(core.FunctionExpr); ok {
+		// the parameters or slots of a nested function shadow the outer ones
+		rule = unshadowedRules(fn, rule)
+		if rule.Length() == 0 {
+			return expr
+		}
 		bodyOut := functionReplaceAll(e, c, fn.Body, rule)
 		//log.Printf("Body in %q, body out %q", fn.Body, bodyOut)
 		if bodyOut.Equal(fn.Body) {
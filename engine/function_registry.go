@@ -2,9 +2,11 @@ package engine
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 
 	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
 )
 
 // PatternFunc represents a Go function that can be called with pattern-matched arguments
@@ -24,24 +26,34 @@ type FunctionDef struct {
 	Specificity int         // Auto-calculated pattern specificity for ordering
 	IsBuiltin   bool        // Whether this definition came from system registrationa
 	prog        core.Prog
+	matcher     *core.Matcher // Pattern compiled once, see core.CompilePattern
+	defaults    bool          // Pattern has an x_. argument, see withDefaults
 }
 
 // FunctionRegistry manages all function definitions (user-defined and built-in) with pattern-based dispatch
 type FunctionRegistry struct {
 	functions map[core.Symbol][]FunctionDef // function name -> ordered list of patterns
+	upValues  map[core.Symbol][]FunctionDef // argument head -> ordered list of patterns
 	re        *core.ThompsonVM
+	version   uint64 // changes with the definitions, see Context.StateVersion
 }
 
 // NewFunctionRegistry creates a new function registry
 func NewFunctionRegistry() *FunctionRegistry {
 	return &FunctionRegistry{
 		functions: make(map[core.Symbol][]FunctionDef),
+		upValues:  make(map[core.Symbol][]FunctionDef),
 		re:        core.NewRegexp(),
+		version:   nextStateVersion(),
 	}
 }
 
-func (r *FunctionRegistry) Clear(sym core.Symbol) {
+// ClearFunction removes all definitions of name, including the up-values
+// attached to it
+func (r *FunctionRegistry) ClearFunction(sym core.Symbol) {
 	delete(r.functions, sym)
+	delete(r.upValues, sym)
+	r.version = nextStateVersion()
 }
 
 // RegisterPatternBuiltins registers multiple built-in functions from a map
@@ -86,22 +98,25 @@ func (r *FunctionRegistry) registerPatternBuiltin(patternStr string, impl Patter
 		Specificity: specificity,
 		IsBuiltin:   true,
 		prog:        prog,
+		matcher:     core.CompilePattern(pattern),
 	}
 
 	definitions := r.functions[functionName]
 	definitions = append(definitions, funcDef)
 	r.functions[functionName] = definitions
+	r.version = nextStateVersion()
 
 	return nil
 }
 
 // registerFunctionDef adds or replaces a function definition
 func (r *FunctionRegistry) registerFunctionDef(functionName core.Symbol, newDef FunctionDef) {
+	r.version = nextStateVersion()
 	definitions := r.functions[functionName]
 
 	// Check if we need to replace an existing equivalent pattern
 	for i, existingDef := range definitions {
-		if core.PatternsEqual(existingDef.Pattern, newDef.Pattern) {
+		if core.PatternsEqual(existingDef.Pattern, newDef.Pattern) && sameCondition(existingDef.Body, newDef.Body) {
 			// Replace existing definition
 			definitions[i] = newDef
 			r.functions[functionName] = definitions
@@ -145,13 +160,20 @@ func (r *FunctionRegistry) RegisterUserFunction(pattern core.Expr, body core.Exp
 		GoImpl:      nil,
 		Specificity: calculatePatternSpecificity(pattern),
 		IsBuiltin:   false,
+		matcher:     core.CompilePattern(pattern),
+		defaults:    needsDefaults(pattern),
 	}
 
 	r.registerFunctionDef(functionName, funcDef)
 	return nil
 }
 
-func (r *FunctionRegistry) FindMatchingFunction2(fn core.Expr) (*FunctionDef, core.PatternBindings) {
+// FindMatchingFunction2 returns the first definition matching fn, in
+// specificity order.  The test function is used to check Condition, both
+// inside patterns and on the right-hand side of user definitions.  The
+// symbols give the Orderless attribute of the head of fn, see
+// matchDefinition, and the defaults of x_. arguments, see withDefaults.
+func (r *FunctionRegistry) FindMatchingFunction2(fn core.Expr, test core.TestFunc, symbols *SymbolTable) (*FunctionDef, core.PatternBindings) {
 
 	list := fn.(core.List)
 	fname := list.Head().(core.Symbol)
@@ -161,6 +183,7 @@ func (r *FunctionRegistry) FindMatchingFunction2(fn core.Expr) (*FunctionDef, co
 	if !exists {
 		return nil, nil
 	}
+	orderless := symbols.HasAttribute(fname, Orderless)
 	for _, def := range definitions {
 		// If a pattern is longer than the function
 		// then it can't match (Maybe.. need to think about this more)
@@ -180,7 +203,10 @@ func (r *FunctionRegistry) FindMatchingFunction2(fn core.Expr) (*FunctionDef, co
 			}
 			continue
 		}
-		if matches, bindings := core.MatchWithBindings(fn, def.Pattern); matches {
+		if def.defaults {
+			def = withDefaults(def, symbols)
+		}
+		if matches, bindings := matchDefinition(fn, def, test, orderless); matches {
 			return &def, bindings
 		}
 	}
@@ -188,6 +214,207 @@ func (r *FunctionRegistry) FindMatchingFunction2(fn core.Expr) (*FunctionDef, co
 
 }
 
+// maxOrderlessArgs is the most arguments whose orderings are tried when
+// matching an orderless call, 6! = 720 orderings
+const maxOrderlessArgs = 6
+
+// matchDefinition matches fn against a user definition, including a
+// Condition on its right-hand side.  If orderless is set, the arguments
+// of fn are tried in every order until one matches, so f(x_, 0) matches
+// f(0, 5) with x bound to 5.
+func matchDefinition(fn core.Expr, def FunctionDef, test core.TestFunc, orderless bool) (bool, core.PatternBindings) {
+	if matches, bindings := matchDefinitionArgs(fn, def, test); matches || !orderless {
+		return matches, bindings
+	}
+	list, ok := fn.(core.List)
+	if !ok || list.Length() < 2 || list.Length() > maxOrderlessArgs {
+		return false, nil
+	}
+	var bindings core.PatternBindings
+	matches := permuteArgs(list.Tail(), func(args []core.Expr) bool {
+		var ok bool
+		ok, bindings = matchDefinitionArgs(core.ListFrom(list.Head(), args...), def, test)
+		return ok
+	})
+	return matches, bindings
+}
+
+// needsDefaults reports whether pattern has an x_. argument, an Optional
+// without a default
+func needsDefaults(pattern core.Expr) bool {
+	list, ok := pattern.(core.List)
+	if !ok || list.Head() == symbol.Verbatim {
+		return false
+	}
+	if p, def := core.IsOptional(list); p != nil && def == nil {
+		return true
+	}
+	for _, e := range list.AsSlice() {
+		if needsDefaults(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// withDefaults returns def with each x_. argument of a function f in its
+// pattern given the default set by Default(f) = value. Arguments of
+// functions without a default are left alone, and must then be given.
+// The defaults are looked up on every match, so they can be set after
+// the definition is made.
+func withDefaults(def FunctionDef, symbols *SymbolTable) FunctionDef {
+	if pattern, changed := fillDefaults(def.Pattern, symbols); changed {
+		def.Pattern = pattern
+		def.matcher = core.CompilePattern(pattern)
+	}
+	return def
+}
+
+// fillDefaults is withDefaults for a pattern, and reports whether any
+// default was filled in
+func fillDefaults(pattern core.Expr, symbols *SymbolTable) (core.Expr, bool) {
+	list, ok := pattern.(core.List)
+	if !ok || list.Head() == symbol.Verbatim {
+		return pattern, false
+	}
+	var value core.Expr
+	if head, ok := list.Head().(core.Symbol); ok {
+		value, _ = symbols.Default(head)
+	}
+	elements := list.AsSlice()
+	var filled []core.Expr
+	for i, e := range elements {
+		next, changed := fillDefaults(e, symbols)
+		if p, def := core.IsOptional(e); i > 0 && p != nil && def == nil && value != nil {
+			next, changed = core.ListFrom(symbol.Optional, p, value), true
+		}
+		if changed && filled == nil {
+			filled = slices.Clone(elements)
+		}
+		if filled != nil {
+			filled[i] = next
+		}
+	}
+	if filled == nil {
+		return pattern, false
+	}
+	return core.NewListFromExprs(filled...), true
+}
+
+// permuteArgs calls try with every other ordering of args until it
+// returns true, and reports whether it did.  args is not changed.
+func permuteArgs(args []core.Expr, try func([]core.Expr) bool) bool {
+	// Heap's algorithm
+	perm := slices.Clone(args)
+	c := make([]int, len(perm))
+	for i := 0; i < len(perm); {
+		if c[i] >= i {
+			c[i] = 0
+			i++
+			continue
+		}
+		if i%2 == 0 {
+			perm[0], perm[i] = perm[i], perm[0]
+		} else {
+			perm[c[i]], perm[i] = perm[i], perm[c[i]]
+		}
+		if try(perm) {
+			return true
+		}
+		c[i]++
+		i = 0
+	}
+	return false
+}
+
+// matchDefinitionArgs is matchDefinition with the arguments in order
+func matchDefinitionArgs(fn core.Expr, def FunctionDef, test core.TestFunc) (bool, core.PatternBindings) {
+	var matches bool
+	var bindings core.PatternBindings
+	if def.matcher != nil {
+		matches, bindings = def.matcher.Match(fn, test)
+	} else {
+		matches, bindings = core.MatchWithTest(fn, def.Pattern, test)
+	}
+	if !matches {
+		return false, nil
+	}
+	if cond := definitionCondition(def.Body); cond != nil {
+		if test == nil || !test(core.SubstituteBindings(cond, bindings)) {
+			return false, nil
+		}
+	}
+	return true, bindings
+}
+
+// RegisterUpValue registers a definition for pattern that is attached to
+// tag, the head of one of its arguments, instead of to the head of
+// pattern: area(c_Circle) ^:= body is attached to Circle
+func (r *FunctionRegistry) RegisterUpValue(tag core.Symbol, pattern core.Expr, body core.Expr) {
+	newDef := FunctionDef{
+		Pattern:     pattern,
+		Body:        body,
+		Specificity: calculatePatternSpecificity(pattern),
+		matcher:     core.CompilePattern(pattern),
+		defaults:    needsDefaults(pattern),
+	}
+	r.version = nextStateVersion()
+	definitions := r.upValues[tag]
+	for i, existingDef := range definitions {
+		if core.PatternsEqual(existingDef.Pattern, newDef.Pattern) && sameCondition(existingDef.Body, newDef.Body) {
+			definitions[i] = newDef
+			return
+		}
+	}
+	definitions = append(definitions, newDef)
+	sortBySpec(definitions)
+	r.upValues[tag] = definitions
+}
+
+// GetUpValues returns all up-value definitions attached to tag
+func (r *FunctionRegistry) GetUpValues(tag core.Symbol) []FunctionDef {
+	if definitions, exists := r.upValues[tag]; exists {
+		result := make([]FunctionDef, len(definitions))
+		copy(result, definitions)
+		return result
+	}
+	return nil
+}
+
+// CallUpValue tries the up-values attached to the heads of the
+// arguments of callExpr, and returns (result, found)
+func (r *FunctionRegistry) CallUpValue(callExpr core.Expr, ctx *Context, e *Evaluator) (core.Expr, bool) {
+	list, ok := callExpr.(core.List)
+	if !ok || len(r.upValues) == 0 {
+		return nil, false
+	}
+	orderless := false
+	if head, ok := list.Head().(core.Symbol); ok {
+		orderless = ctx.symbolTable.HasAttribute(head, Orderless)
+	}
+	for _, arg := range list.Tail() {
+		tag, ok := arg.(core.Symbol)
+		if !ok {
+			if tag, ok = arg.Head().(core.Symbol); !ok {
+				continue
+			}
+		}
+		for _, def := range r.upValues[tag] {
+			if def.defaults {
+				def = withDefaults(def, ctx.symbolTable)
+			}
+			if matches, bindings := matchDefinition(callExpr, def, e.testTrue, orderless); matches {
+				body := def.Body
+				if rhs, cond := core.IsCondition(body); cond != nil {
+					body = rhs
+				}
+				return core.SubstituteBindings(body, bindings), true
+			}
+		}
+	}
+	return nil, false
+}
+
 // GetFunctionDefinitions returns all definitions for a function name (for debugging/introspection)
 func (r *FunctionRegistry) GetFunctionDefinitions(functionName core.Symbol) []FunctionDef {
 	if definitions, exists := r.functions[functionName]; exists {
@@ -199,6 +426,59 @@ func (r *FunctionRegistry) GetFunctionDefinitions(functionName core.Symbol) []Fu
 	return nil
 }
 
+// DownValues returns the user definitions of name as
+// RuleDelayed(HoldPattern(lhs), rhs), in the order they are tried
+func (r *FunctionRegistry) DownValues(name core.Symbol) []core.Expr {
+	var rules []core.Expr
+	for _, def := range r.functions[name] {
+		if def.IsBuiltin {
+			continue
+		}
+		lhs := core.ListFrom(symbol.HoldPattern, def.Pattern)
+		rules = append(rules, core.ListFrom(symbol.RuleDelayed, lhs, def.Body))
+	}
+	return rules
+}
+
+// SetDownValues replaces the user definitions of name with rules of the
+// form returned by DownValues.  Builtin definitions are kept.
+func (r *FunctionRegistry) SetDownValues(name core.Symbol, rules []core.Expr) error {
+	defs := make([]FunctionDef, 0, len(rules))
+	for _, rule := range rules {
+		list, ok := rule.(core.List)
+		if !ok || list.Length() != 2 || (list.Head() != symbol.RuleDelayed && list.Head() != symbol.Rule) {
+			return fmt.Errorf("expected a rule, got %s", rule)
+		}
+		lhs, rhs := list.Tail()[0], list.Tail()[1]
+		if lhs.Head() == symbol.HoldPattern && lhs.Length() == 1 {
+			lhs = lhs.(core.List).Tail()[0]
+		}
+		if lhs.Head() != name {
+			return fmt.Errorf("rule %s is not a definition of %s", rule, name)
+		}
+		defs = append(defs, FunctionDef{
+			Pattern:     lhs,
+			Body:        rhs,
+			Specificity: calculatePatternSpecificity(lhs),
+			matcher:     core.CompilePattern(lhs),
+			defaults:    needsDefaults(lhs),
+		})
+	}
+
+	var builtins []FunctionDef
+	for _, def := range r.functions[name] {
+		if def.IsBuiltin {
+			builtins = append(builtins, def)
+		}
+	}
+	r.functions[name] = builtins
+	r.version = nextStateVersion()
+	for _, def := range defs {
+		r.registerFunctionDef(name, def)
+	}
+	return nil
+}
+
 // GetAllFunctionNames returns all registered function names
 func (r *FunctionRegistry) GetAllFunctionNames() []core.Symbol {
 	names := make([]core.Symbol, 0, len(r.functions))
@@ -218,7 +498,7 @@ func (r *FunctionRegistry) CallFunction(callExpr core.Expr, ctx *Context, e *Eva
 		return nil, false
 	}
 
-	funcDef, bindings := r.FindMatchingFunction2(callExpr)
+	funcDef, bindings := r.FindMatchingFunction2(callExpr, e.testTrue, ctx.symbolTable)
 	if funcDef == nil {
 		return nil, false
 	}
@@ -243,7 +523,11 @@ func (r *FunctionRegistry) CallFunction(callExpr core.Expr, ctx *Context, e *Eva
 		return result, true
 	}
 
-	return core.SubstituteBindings(funcDef.Body, bindings), true
+	body := funcDef.Body
+	if rhs, cond := core.IsCondition(body); cond != nil {
+		body = rhs
+	}
+	return core.SubstituteBindings(body, bindings), true
 }
 
 // couldPatternsConflict checks if two patterns could potentially match the same arguments
@@ -334,14 +618,40 @@ func calculatePatternSpecificity(pattern core.Expr) int {
 	return int(core.GetPatternSpecificity(pattern))
 }
 
+// definitionCondition returns the test of a body written as
+// Condition(rhs, test), or nil if the definition is unconditional
+func definitionCondition(body core.Expr) core.Expr {
+	if body == nil {
+		return nil
+	}
+	_, cond := core.IsCondition(body)
+	return cond
+}
+
+// sameCondition reports whether two definition bodies have the same
+// Condition test (or both have none), so one may replace the other
+func sameCondition(body1, body2 core.Expr) bool {
+	cond1, cond2 := definitionCondition(body1), definitionCondition(body2)
+	if cond1 == nil || cond2 == nil {
+		return cond1 == nil && cond2 == nil
+	}
+	return cond1.Equal(cond2)
+}
+
 func sortBySpec(v []FunctionDef) {
-	sort.Slice(v, func(i, j int) bool {
+	sort.SliceStable(v, func(i, j int) bool {
 		// Higher specificity comes first
 		if v[i].Specificity != v[j].Specificity {
 			return v[i].Specificity > v[j].Specificity
 		}
 		// Tie-breaker: use lexicographic order of pattern strings for stability
 		// This ensures Integer patterns come before Number patterns when specificity is equal
-		return v[i].Pattern.String() < v[j].Pattern.String()
+		pi, pj := v[i].Pattern.String(), v[j].Pattern.String()
+		if pi != pj {
+			return pi < pj
+		}
+		// Same pattern: conditional definitions are tried first, otherwise
+		// definitions keep the order they were made in
+		return definitionCondition(v[i].Body) != nil && definitionCondition(v[j].Body) == nil
 	})
 }
@@ -0,0 +1,59 @@
+package engine
+
+import (
+	"github.com/client9/cardinal/core"
+	"github.com/client9/cardinal/core/symbol"
+)
+
+// tracer records the intermediate expressions of an evaluation.
+// Each evaluation of a symbol or list gets a frame that starts with the
+// expression itself, followed by the traces of its sub-evaluations and
+// each new form it is rewritten to.
+type tracer struct {
+	frames [][]core.Expr
+	splice bool // the next evaluation continues the current frame
+}
+
+// push starts a frame for the evaluation of expr
+func (t *tracer) push(expr core.Expr) {
+	t.frames = append(t.frames, []core.Expr{traceForm(expr)})
+}
+
+// pop ends the current frame, adding it to its parent if anything
+// happened besides the expression itself
+func (t *tracer) pop() {
+	n := len(t.frames) - 1
+	frame := t.frames[n]
+	t.frames = t.frames[:n]
+	if len(frame) > 1 {
+		t.frames[n-1] = append(t.frames[n-1], core.ListFrom(symbol.List, frame...))
+	}
+}
+
+// record adds a new form of the expression in the current frame
+func (t *tracer) record(expr core.Expr) {
+	n := len(t.frames) - 1
+	t.frames[n] = append(t.frames[n], traceForm(expr))
+}
+
+// traceForm keeps a traced expression from being evaluated again
+func traceForm(expr core.Expr) core.Expr {
+	return core.ListFrom(symbol.Hold, expr)
+}
+
+// Trace evaluates expr and returns a nested list of the expressions
+// produced along the way, each wrapped in Hold:
+// Trace(2 + 3 * 4) is
+// [Hold(2 + 3 * 4), [Hold(3 * 4), Hold(12)], Hold(2 + 12), Hold(14)]
+func (e *Evaluator) Trace(expr core.Expr) core.List {
+	saved := e.tracer
+	e.tracer = &tracer{frames: [][]core.Expr{nil}}
+	defer func() { e.tracer = saved }()
+
+	e.Evaluate(expr)
+
+	if root := e.tracer.frames[0]; len(root) > 0 {
+		return root[0].(core.List)
+	}
+	return core.ListFrom(symbol.List)
+}
@@ -0,0 +1,42 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestAlternatives(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Definition matches Integer and Real but not String",
+			input:    "f(x_Integer | x_Real) := x * 2; [f(2), f(1.5), f(\"a\")]",
+			expected: "List(4, 3.0, f(\"a\"))",
+		},
+		{
+			name:     "Binding from whichever branch matched",
+			input:    "g(h(x_) | k(x_)) := x; [g(h(1)), g(k(2)), g(m(3))]",
+			expected: "List(1, 2, g(m(3)))",
+		},
+		{
+			name:     "Literal alternatives",
+			input:    "color(red | green | blue) := True; color(x_) := False; [color(green), color(pink)]",
+			expected: "List(True, False)",
+		},
+		{
+			name:     "Alternatives are no more specific than their choices",
+			input:    "s(x_) := general; s(x_Integer | x_String) := typed; [s(1), s(\"a\"), s(1.5)]",
+			expected: "List(typed, typed, general)",
+		},
+		{
+			name:     "MatchQ with alternatives",
+			input:    "[MatchQ(1, _Integer | _String), MatchQ(1.5, _Integer | _String)]",
+			expected: "List(True, False)",
+		},
+		{
+			name:     "ReplaceAll with alternatives",
+			input:    "[a, b, c] /. (a | c) : z",
+			expected: "List(z, b, z)",
+		},
+	}
+
+	runTestCases(t, tests)
+}
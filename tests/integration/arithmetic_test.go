@@ -231,3 +231,87 @@ func TestUnaryMinus(t *testing.T) {
 
 	runTestCases(t, tests)
 }
+
+func TestPowerDispatch(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Exact integer power",
+			input:    "[Power(2, 3), 2^100, (-3)^3]",
+			expected: "List(8, 1267650600228229401496703205376, -27)",
+		},
+		{
+			name:     "Negative exponent gives a Rational",
+			input:    "[2^-2, (-2)^-3, 1^-5, (-1)^-1]",
+			expected: "List(1/4, -1/8, 1, -1)",
+		},
+		{
+			name:     "Rational base",
+			input:    "[(2/3)^2, (2/3)^-2, (1/2)^-1]",
+			expected: "List(4/9, 9/4, 2)",
+		},
+		{
+			name:     "Real base uses floating point",
+			input:    "[2.0^3, 2.0^(1/2), (1/4)^0.5]",
+			expected: "List(8.0, 1.4142135623730951, 0.5)",
+		},
+		{
+			name:     "Zero to the zero is one",
+			input:    "0^0",
+			expected: "1",
+		},
+		{
+			name:      "Zero to a negative power",
+			input:     "0^-1",
+			errorType: "DivisionByZero",
+		},
+		{
+			name:      "Zero to a negative power other than -1",
+			input:     "0^-3",
+			errorType: "DivisionByZero",
+		},
+		{
+			name:     "Exponent too large for an int64 with base 0, 1 or -1",
+			input:    "[0^(10^20), 1^(10^20), 1^-(10^20), (-1)^(10^20), (-1)^(10^20 + 1), (-1)^-(10^20 + 1)]",
+			expected: "List(0, 1, 1, 1, -1, -1)",
+		},
+		{
+			name:      "Exponent too large for an int64",
+			input:     "2^(10^20)",
+			errorType: "Overflow",
+		},
+		{
+			name:      "Negative exponent too large for an int64",
+			input:     "2^-(10^20)",
+			errorType: "Overflow",
+		},
+		{
+			name:     "Rationals that reduce to integers",
+			input:    "[1/2 + 1/2, 14 * (1/2), 4 * (1/2) * x]",
+			expected: "List(1, 7, Times(2, x))",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestAbs(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Abs of each number type",
+			input:    "[Abs(-3), Abs(3), Abs(-2.5), Abs(-2/3), Abs(0)]",
+			expected: "List(3, 3, 2.5, 2/3, 0)",
+		},
+		{
+			name:     "Abs of a big integer",
+			input:    "Abs(-100000000000000000000)",
+			expected: "100000000000000000000",
+		},
+		{
+			name:     "Abs of a symbol stays unevaluated",
+			input:    "Abs(x)",
+			expected: "Abs(x)",
+		},
+	}
+
+	runTestCases(t, tests)
+}
@@ -0,0 +1,69 @@
+package integration
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/client9/cardinal"
+	"github.com/client9/cardinal/core"
+)
+
+func TestAssert(t *testing.T) {
+	tests := []TestCase{
+		{name: "true condition", input: "Assert(1 + 1 == 2)", expected: "Null"},
+		{name: "false condition", input: "Assert(1 + 1 == 3)", errorType: "AssertionError"},
+		{name: "undecided condition", input: "Assert(x)", errorType: "AssertionError"},
+		{name: "continues after success", input: "Assert(True); 5", expected: "5"},
+		{name: "stops a compound expression", input: "Assert(False); 5", errorType: "AssertionError"},
+		{name: "error in condition", input: "Assert(1/0 == 1)", errorType: "DivisionByZero"},
+	}
+	runTestCases(t, tests)
+}
+
+func TestAssertMessage(t *testing.T) {
+	result, err := cardinal.EvaluateString("Assert(1 + 1 == 3)")
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	errExpr, ok := core.AsError(result)
+	if !ok {
+		t.Fatalf("expected an error, got %s", result)
+	}
+	want := "assertion 1 + 1 == 3 failed"
+	if got := errExpr.StackTrace()[0].Message; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		messages []string
+	}{
+		{name: "template", input: "Message(\"x is `1` and `2`\", 5, \"s\")", messages: []string{"x is 5 and s"}},
+		{name: "symbol tag", input: "Message(f, 1, \"a\")", messages: []string{"f: 1, a"}},
+		{name: "tag only", input: "Message(f)", messages: []string{"f"}},
+		{name: "several", input: "Message(a); Message(b, 2); 3", messages: []string{"a", "b: 2"}},
+		{name: "none", input: "1 + 1", messages: nil},
+	}
+	for _, tt := range tests {
+		e := cardinal.NewEvaluator()
+		expr, err := e.ParseString(tt.input)
+		if err != nil {
+			t.Fatalf("%s: parse error: %v", tt.name, err)
+		}
+		e.Evaluate(expr)
+		if got := e.GetContext().Messages(); !slices.Equal(got, tt.messages) {
+			t.Errorf("%s: expected messages %q, got %q", tt.name, tt.messages, got)
+		}
+	}
+}
+
+func TestMessageDoesNotAbort(t *testing.T) {
+	tests := []TestCase{
+		{name: "returns Null", input: "Message(f, 1)", expected: "Null"},
+		{name: "evaluation continues", input: "Message(f, 1); 1 + 2", expected: "3"},
+	}
+	runTestCases(t, tests)
+}
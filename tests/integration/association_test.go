@@ -61,6 +61,31 @@ func TestAssociation(t *testing.T) {
 			input:    "Part({\"key\": \"value\"}, \"key\")",
 			expected: "\"value\"",
 		},
+		{
+			name:     "Access missing key",
+			input:    "Part({name: \"Bob\"}, missing)",
+			expected: "Missing(\"KeyAbsent\", missing)",
+		},
+		{
+			name:     "Index with brackets",
+			input:    "a = {\"x\": 1}; a[\"x\"]",
+			expected: "1",
+		},
+		{
+			name:     "Index missing key with brackets",
+			input:    "a = {\"x\": 1}; a[\"y\"]",
+			expected: "Missing(\"KeyAbsent\", \"y\")",
+		},
+		{
+			name:     "Nested indexing",
+			input:    "a = {\"x\": {\"y\": 2}}; a[\"x\"][\"y\"]",
+			expected: "2",
+		},
+		{
+			name:     "Integer keys are not positions",
+			input:    "{1: \"a\", 3: \"b\"}[3]",
+			expected: "\"b\"",
+		},
 		{
 			name:     "Equal empty associations",
 			input:    "SameQ({}, {})",
@@ -101,6 +126,16 @@ func TestAssociation(t *testing.T) {
 			input:    "InputForm(Association(Rule(a,1), Rule(b,2)))",
 			expected: "\"{a: 1, b: 2}\"",
 		},
+		{
+			name:     "Compound keys",
+			input:    `a = {[1, 2]: "list", f(x): "call"}; [Part(a, [1, 2]), Part(a, f(x)), Keys(Append(a, [1, 2] : "again"))]`,
+			expected: `List("list", "call", List(List(1, 2), f(x)))`,
+		},
+		{
+			name:     "Integer and real keys are different",
+			input:    `Keys({1: "one", 1.0: "real", 1: "uno"})`,
+			expected: "List(1, 1.0)",
+		},
 		{
 			name:     "Add with Part syntax",
 			input:    "m = Association(Rule(a,1), Rule(b,2)); Part(m, c) = 3",
@@ -110,7 +145,7 @@ func TestAssociation(t *testing.T) {
 		{
 			name:     "Strings and Symbols are different keys",
 			input:    "Assert(Length(Keys({a:1,\"a\":2})) == 2)",
-			expected: "True",
+			expected: "Null",
 		},
 		{
 			name:     "Add with Part syntax",
@@ -116,6 +116,47 @@ func TestAttributeProtection(t *testing.T) {
 			input:    "w = 1; SetAttributes(w, Protected); ClearAttributes(w, Protected); w = 2",
 			expected: "2",
 		},
+		{
+			name:      "Attributes of a Protected symbol cannot be set",
+			input:     "SetAttributes(Plus, HoldAll)",
+			errorType: "Protected",
+		},
+		{
+			name:      "Attributes of a Protected symbol cannot be cleared",
+			input:     "ClearAttributes(Plus, Orderless)",
+			errorType: "Protected",
+		},
+		{
+			name:      "Locked symbol cannot be unprotected",
+			input:     "SetAttributes(v, [Protected, Locked]); ClearAttributes(v, Protected)",
+			errorType: "Protected",
+		},
+		{
+			name:      "ClearAttributes with an unknown attribute",
+			input:     "ClearAttributes(v, InvalidAttribute)",
+			errorType: "UnknownAttribute",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestUserAttributes(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "HoldFirst on a user symbol holds the first argument",
+			input:    "SetAttributes(hf, HoldFirst); hf(x_, y_) := Hold(x, y); hf(1 + 1, 2 + 2)",
+			expected: "Hold(Plus(1, 1), 4)",
+		},
+		{
+			name:     "Without HoldFirst both arguments are evaluated",
+			input:    "hf(x_, y_) := Hold(x, y); hf(1 + 1, 2 + 2)",
+			expected: "Hold(2, 4)",
+		},
+		{
+			name:     "Clearing HoldFirst evaluates again",
+			input:    "SetAttributes(hf, HoldFirst); ClearAttributes(hf, HoldFirst); hf(x_) := Hold(x); hf(1 + 1)",
+			expected: "Hold(2)",
+		},
 	}
 	runTestCases(t, tests)
 }
@@ -0,0 +1,52 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestBlankHead(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Definition for a user-defined head",
+			input:    "norm(p_Point) := Sqrt(p[1]^2 + p[2]^2); norm(Point(3, 4))",
+			expected: "5",
+		},
+		{
+			name:     "Other heads do not match",
+			input:    "norm(p_Point) := Sqrt(p[1]^2 + p[2]^2); norm(Point3D(1, 2, 2))",
+			expected: "norm(Point3D(1, 2, 2))",
+		},
+		{
+			name:     "User-defined head is tried before a plain blank",
+			input:    "area(x_) := unknown; area(c_Circle) := circle; [area(Circle(1)), area(Square(1))]",
+			expected: "List(circle, unknown)",
+		},
+		{
+			name:     "Complex head",
+			input:    "re(z_Complex) := z[1]; [re(Complex(1, 2)), re(1)]",
+			expected: "List(1, re(1))",
+		},
+		{
+			name:     "Sequence of a user-defined head",
+			input:    "path(p__Point) := Length([p]); [path(Point(1), Point(2)), path(Point(1), Line(2))]",
+			expected: "List(2, path(Point(1), Line(2)))",
+		},
+		{
+			name:     "A string head is not a symbol",
+			input:    `MatchQ("Point"(1), _Point)`,
+			expected: "False",
+		},
+		{
+			name:     "Head that is not a symbol",
+			input:    "[MatchQ(f(1)(2), Blank(f(1))), MatchQ(f(2)(2), Blank(f(1))), MatchQ(x, Blank(f(1)))]",
+			expected: "List(True, False, False)",
+		},
+		{
+			name:     "Cases with a user-defined head",
+			input:    "Cases([Point(1, 2), Point3D(1, 2, 3), Point(3)], _Point)",
+			expected: "List(Point(1, 2), Point(3))",
+		},
+	}
+
+	runTestCases(t, tests)
+}
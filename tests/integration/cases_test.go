@@ -0,0 +1,118 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestCases(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Cases with a typed pattern",
+			input:    "Cases([1, a, 2, \"b\"], _Integer)",
+			expected: "List(1, 2)",
+		},
+		{
+			name:     "Cases with a condition",
+			input:    "Cases([1, 2, 3, 4], x_ /; x > 2)",
+			expected: "List(3, 4)",
+		},
+		{
+			name:     "Cases with a pattern test",
+			input:    "Cases([1, a, 2, b], _?IntegerQ)",
+			expected: "List(1, 2)",
+		},
+		{
+			name:     "Cases with a user-defined pattern test",
+			input:    "big(x_) := x > 10; Cases([5, 50, 500], _?big)",
+			expected: "List(50, 500)",
+		},
+		{
+			name:     "Cases with a rule",
+			input:    "Cases([1, a, 2], x_Integer : x * 10)",
+			expected: "List(10, 20)",
+		},
+		{
+			name:     "Cases with a conditional rule",
+			input:    "Cases([1, 2, 3, 4], (x_ /; x > 2) => x^2)",
+			expected: "List(9, 16)",
+		},
+		{
+			name:     "Cases with no matches",
+			input:    "Cases([1, 2], _String)",
+			expected: "List()",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestCount(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Count with a typed pattern",
+			input:    "Count([1, a, 2, b], _Symbol)",
+			expected: "2",
+		},
+		{
+			name:     "Count with a condition",
+			input:    "Count([1, 2, 3, 4, 5], x_ /; x >= 3)",
+			expected: "3",
+		},
+		{
+			name:     "Count with a pattern test",
+			input:    "Count([1, \"a\", 2.5, b], _?NumberQ)",
+			expected: "2",
+		},
+		{
+			name:     "Count with alternatives",
+			input:    "Count([1, \"a\", 2.5, b], _Integer | _String)",
+			expected: "2",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestPosition(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Position at the top level",
+			input:    "Position([1, 2, 3, 4], x_ /; x > 2)",
+			expected: "List(List(3), List(4))",
+		},
+		{
+			name:     "Position in nested lists",
+			input:    "Position([1, [2, a]], _Integer)",
+			expected: "List(List(1), List(2, 1))",
+		},
+		{
+			name:     "Position of the whole expression",
+			input:    "Position(f(a), f(_))",
+			expected: "List(List())",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestMatchQCondition(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "MatchQ with a condition",
+			input:    "[MatchQ(5, x_ /; x > 2), MatchQ(1, x_ /; x > 2)]",
+			expected: "List(True, False)",
+		},
+		{
+			name:     "MatchQ with a pattern test",
+			input:    "[MatchQ(5, _?IntegerQ), MatchQ(a, _?IntegerQ)]",
+			expected: "List(True, False)",
+		},
+		{
+			name:     "Replace with a condition",
+			input:    "[Replace(5, (x_ /; x > 2) : big), Replace(1, (x_ /; x > 2) : big)]",
+			expected: "List(big, 1)",
+		},
+	}
+
+	runTestCases(t, tests)
+}
@@ -0,0 +1,52 @@
+package integration
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/client9/cardinal"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []TestCase{
+		{name: "no error", input: "Check(1 + 1, \"failed\")", expected: "2"},
+		{name: "error is replaced", input: "Check(1/0, \"failed\")", expected: "\"failed\""},
+		{name: "failed Assert", input: "Check(Assert(False), x)", expected: "x"},
+		{name: "message is a failure", input: "Check(Message(f, 1); 2, \"failed\")", expected: "\"failed\""},
+		{name: "error that does not surface", input: "Check(If(IntegerQ(First([])), 1, 2), \"failed\")", expected: "\"failed\""},
+		{name: "failexpr is held until needed", input: "n = 0; Check(1, n = 1); n", expected: "0"},
+		{name: "failexpr is evaluated", input: "Check(1/0, 1 + 2)", expected: "3"},
+		{name: "Throw passes through", input: "Catch(Check(Throw(3), \"failed\"))", expected: "3"},
+		{name: "caught Throw is not a failure", input: "Check(Catch(Throw(3)), \"failed\")", expected: "3"},
+		{name: "quiet message is not a failure", input: "Check(Quiet(Message(f, 1); 2), \"failed\")", expected: "2"},
+	}
+	runTestCases(t, tests)
+}
+
+func TestQuiet(t *testing.T) {
+	tests := []TestCase{
+		{name: "returns the result", input: "Quiet(Message(f, 1); 2)", expected: "2"},
+		{name: "errors are still returned", input: "Quiet(1/0)", errorType: "DivisionByZero"},
+	}
+	runTestCases(t, tests)
+
+	messages := []struct {
+		name     string
+		input    string
+		messages []string
+	}{
+		{name: "message is swallowed", input: "Quiet(Message(f, 1); 2)", messages: nil},
+		{name: "earlier messages are kept", input: "Message(g); Quiet(Message(f, 1)); Message(h)", messages: []string{"g", "h"}},
+	}
+	for _, tt := range messages {
+		e := cardinal.NewEvaluator()
+		expr, err := e.ParseString(tt.input)
+		if err != nil {
+			t.Fatalf("%s: parse error: %v", tt.name, err)
+		}
+		e.Evaluate(expr)
+		if got := e.GetContext().Messages(); !slices.Equal(got, tt.messages) {
+			t.Errorf("%s: expected messages %q, got %q", tt.name, tt.messages, got)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestClear(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Clear removes a value",
+			input:    "x = 5; Clear(x); x",
+			expected: "x",
+		},
+		{
+			name:     "Clear removes definitions",
+			input:    "f(x_) := x + 1; f(0) = 10; Clear(f); [f(0), f(1)]",
+			expected: "List(f(0), f(1))",
+		},
+		{
+			name:     "Clear several symbols",
+			input:    "a = 1; b = 2; Clear(a, b); [a, b]",
+			expected: "List(a, b)",
+		},
+		{
+			name:     "Clear keeps attributes",
+			input:    "SetAttributes(f, HoldFirst); f(x_) := x; Clear(f); Attributes(f)",
+			expected: "List(HoldFirst)",
+		},
+		{
+			name:     "A cleared function can be defined again",
+			input:    "f(x_) := x + 1; Clear(f); f(x_) := x * 2; f(5)",
+			expected: "10",
+		},
+		{
+			name:      "Clear of a Protected symbol",
+			input:     "Clear(Plus)",
+			errorType: "Protected",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestClearAll(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "ClearAll removes values and definitions",
+			input:    "x = 5; f(y_) := y; ClearAll(x, f); [x, f(1)]",
+			expected: "List(x, f(1))",
+		},
+		{
+			name:     "ClearAll removes attributes",
+			input:    "SetAttributes(f, HoldFirst); f(x_) := Hold(x); ClearAll(f); [Attributes(f), f(1 + 1)]",
+			expected: "List(List(), f(2))",
+		},
+		{
+			name:      "ClearAll of a Protected symbol",
+			input:     "SetAttributes(g, Protected); ClearAll(g)",
+			errorType: "Protected",
+		},
+	}
+
+	runTestCases(t, tests)
+}
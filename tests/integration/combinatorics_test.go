@@ -0,0 +1,88 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestFactorial(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Small values",
+			input:    `[Factorial(0), Factorial(1), Factorial(5)]`,
+			expected: "List(1, 1, 120)",
+		},
+		{
+			name:     "Largest machine factorial",
+			input:    `Factorial(20)`,
+			expected: "2432902008176640000",
+		},
+		{
+			name:     "Promotes to big integer",
+			input:    `Factorial(30)`,
+			expected: "265252859812191058636308480000000",
+		},
+		{
+			name:     "Symbolic argument",
+			input:    `Factorial(x)`,
+			expected: "Factorial(x)",
+		},
+		{
+			name:      "Negative argument",
+			input:     `Factorial(-1)`,
+			errorType: "ArgumentError",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestBinomial(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Basic",
+			input:    `[Binomial(10, 3), Binomial(5, 0), Binomial(5, 5)]`,
+			expected: "List(120, 1, 1)",
+		},
+		{
+			name:     "Out of range k",
+			input:    `[Binomial(3, 5), Binomial(3, -1)]`,
+			expected: "List(0, 0)",
+		},
+		{
+			name:     "Big result",
+			input:    `Binomial(100, 50)`,
+			expected: "100891344545564193334812497256",
+		},
+		{
+			name:     "Big n with a small k",
+			input:    `[Binomial(10^20, 2), Binomial(10^20, 3), Binomial(10^20, 99999999999999999999), Binomial(10^20, 0)]`,
+			expected: "List(4999999999999999999950000000000000000000, 166666666666666666661666666666666666666700000000000000000000, 100000000000000000000, 1)",
+		},
+		{
+			name:     "Big n with a big k stays unevaluated",
+			input:    `Binomial(10^20, 10^10)`,
+			expected: "Binomial(100000000000000000000, 10000000000)",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestFibonacci(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Small values",
+			input:    `[Fibonacci(0), Fibonacci(1), Fibonacci(2), Fibonacci(10)]`,
+			expected: "List(0, 1, 1, 55)",
+		},
+		{
+			name:     "Negative index",
+			input:    `[Fibonacci(-7), Fibonacci(-8)]`,
+			expected: "List(13, -21)",
+		},
+		{
+			name:     "Promotes to big integer",
+			input:    `[Fibonacci(92), Fibonacci(100)]`,
+			expected: "List(7540113804746346429, 354224848179261915075)",
+		},
+	}
+	runTestCases(t, tests)
+}
@@ -38,7 +38,7 @@ func TestHashComments(t *testing.T) {
 		},
 		{
 			name:     "Multiple comments",
-			input:    "# first comment\nx = 5 # inline comment\n# final comment\nx",
+			input:    "# first comment\nx = 5; # inline comment\n# final comment\nx",
 			expected: "5",
 		},
 		{
@@ -0,0 +1,46 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestComparisonChain(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Variadic Less",
+			input:    `[Less(1, 2, 3), Less(1, 3, 2), Less(1, 1)]`,
+			expected: "List(True, False, False)",
+		},
+		{
+			name:     "Chained operators",
+			input:    `[1 < 2 < 3, 3 > 2 > 1, 1 <= 1 <= 2, 3 >= 3 >= 4]`,
+			expected: "List(True, True, True, False)",
+		},
+		{
+			name:     "Mixed number types",
+			input:    `[1/2 < 1 < 1.5, 2 > 1/2, 1 <= 1.0]`,
+			expected: "List(True, True, True)",
+		},
+		{
+			name:     "Zero and one argument",
+			input:    `[Less(), Less(x), Greater(5)]`,
+			expected: "List(True, True, True)",
+		},
+		{
+			name:     "Symbolic stays unevaluated",
+			input:    `[x < y, Less(1, x), 1 < x < 0]`,
+			expected: "List(Less(x, y), Less(1, x), Less(1, x, 0))",
+		},
+		{
+			name:     "Numbers in order are dropped",
+			input:    `[1 < 2 < x < 5 < 6, 5 > 2 > x, x <= 1 <= 2 <= 3 <= y]`,
+			expected: "List(Less(2, x, 5), Greater(2, x), LessEqual(x, 1, 3, y))",
+		},
+		{
+			name:     "Numbers out of order are False",
+			input:    `[1 < 2 < x < 5 < 4, 2 > 3 > x]`,
+			expected: "List(False, False)",
+		},
+	}
+	runTestCases(t, tests)
+}
@@ -0,0 +1,67 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestCondition(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Condition on body selects definition",
+			input:    "abs(x_) := x /; x >= 0; abs(x_) := -x; [abs(3), abs(-4), abs(0)]",
+			expected: "List(3, 4, 0)",
+		},
+		{
+			name:     "Conditional definition is tried before fallback defined first",
+			input:    "abs(x_) := -x; abs(x_) := x /; x >= 0; [abs(3), abs(-4)]",
+			expected: "List(3, 4)",
+		},
+		{
+			name:     "Redefining with the same condition replaces it",
+			input:    "g(x_) := 1 /; x > 0; g(x_) := 2 /; x > 0; g(5)",
+			expected: "2",
+		},
+		{
+			name:     "Different conditions are kept separately",
+			input:    "sign(x_) := 1 /; x > 0; sign(x_) := -1 /; x < 0; sign(x_) := 0; [sign(7), sign(-7), sign(0)]",
+			expected: "List(1, -1, 0)",
+		},
+		{
+			name:     "No definition applies",
+			input:    "pos(x_) := x /; x > 0; pos(-1)",
+			expected: "pos(-1)",
+		},
+		{
+			name:     "Condition on argument pattern",
+			input:    "h(x_ /; x > 10) := big; h(x_) := small; [h(20), h(5)]",
+			expected: "List(big, small)",
+		},
+		{
+			name:     "Condition using several variables",
+			input:    "ordered(a_, b_) := True /; a < b; ordered(a_, b_) := False; [ordered(1, 2), ordered(2, 1)]",
+			expected: "List(True, False)",
+		},
+		{
+			name:     "Recursive definition with condition",
+			input:    "fact(n_) := n * fact(n - 1) /; n > 0; fact(0) := 1; fact(5)",
+			expected: "120",
+		},
+		{
+			name:     "Condition on the whole left side",
+			input:    "f(x_) /; x > 0 := 1; [f(2), f(-1)]",
+			expected: "List(1, f(-1))",
+		},
+		{
+			name:     "Condition on the left side is kept with the definition",
+			input:    "f(x_) /; x > 0 := 1; f(x_) /; x < 0 := -1; [f(2), f(-2), f(0), DownValues(f)]",
+			expected: "List(1, -1, f(0), List(RuleDelayed(HoldPattern(f(Pattern(x, Blank()))), Condition(1, Greater(x, 0))), RuleDelayed(HoldPattern(f(Pattern(x, Blank()))), Condition(-1, Less(x, 0)))))",
+		},
+		{
+			name:     "Conditions on both sides must both hold",
+			input:    "f(x_) /; x > 0 := 1 /; x < 5; [f(2), f(7), f(-1)]",
+			expected: "List(1, f(7), f(-1))",
+		},
+	}
+
+	runTestCases(t, tests)
+}
@@ -0,0 +1,52 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestCurry(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Curry a user function",
+			input:    "add(a_, b_, c_) := a + b + c; add3 = Curry(add, 1, 2); add3(3)",
+			expected: "6",
+		},
+		{
+			name:     "Curry with one argument, call with two",
+			input:    "add(a_, b_, c_) := a + b + c; Curry(add, 100)(20, 3)",
+			expected: "123",
+		},
+		{
+			name:     "Curry with no arguments",
+			input:    "Curry(f)(1, 2)",
+			expected: "f(1, 2)",
+		},
+		{
+			name:     "Calling with no remaining arguments",
+			input:    "Curry(f, 1, 2)()",
+			expected: "f(1, 2)",
+		},
+		{
+			name:     "Curry a builtin",
+			input:    "Map(Curry(Plus, 10), [1, 2, 3])",
+			expected: "List(11, 12, 13)",
+		},
+		{
+			name:     "Captured arguments are evaluated once",
+			input:    "x = 5; g = Curry(List, x); x = 7; g(x)",
+			expected: "List(5, 7)",
+		},
+		{
+			name:     "Curry a curried function",
+			input:    "Curry(Curry(f, 1), 2)(3)",
+			expected: "f(1, 2, 3)",
+		},
+		{
+			name:     "Sequence slot in pure function",
+			input:    "Function(g(0, $$))(1, 2)",
+			expected: "g(0, 1, 2)",
+		},
+	}
+
+	runTestCases(t, tests)
+}
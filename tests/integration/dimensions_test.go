@@ -0,0 +1,32 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestLengthDimensionsDepth(t *testing.T) {
+	tests := []TestCase{
+		{name: "Length of symbol", input: "Length(x)", expected: "0"},
+		{name: "Length of integer", input: "Length(5)", expected: "0"},
+		{name: "Length of list", input: "Length([1, 2, 3])", expected: "3"},
+		{name: "Length of compound", input: "Length(f(a, b))", expected: "2"},
+		{name: "Length of empty list", input: "Length([])", expected: "0"},
+
+		{name: "Dimensions of matrix", input: "Dimensions([[1, 2, 3], [4, 5, 6]])", expected: "List(2, 3)"},
+		{name: "Dimensions of vector", input: "Dimensions([1, 2, 3])", expected: "List(3)"},
+		{name: "Dimensions of ragged list", input: "Dimensions([[1, 2], [3]])", expected: "List(2)"},
+		{name: "Dimensions of mixed list", input: "Dimensions([1, [2]])", expected: "List(2)"},
+		{name: "Dimensions of rank 3", input: "Dimensions([[[1], [2]], [[3], [4]]])", expected: "List(2, 2, 1)"},
+		{name: "Dimensions of empty list", input: "Dimensions([])", expected: "List(0)"},
+		{name: "Dimensions of atom", input: "Dimensions(x)", expected: "List()"},
+		{name: "Dimensions with other head", input: "Dimensions(f(f(a, b), f(c, d)))", expected: "List(2, 2)"},
+		{name: "Dimensions stops at different head", input: "Dimensions([f(a), f(b)])", expected: "List(2)"},
+
+		{name: "Depth of atom", input: "Depth(x)", expected: "1"},
+		{name: "Depth of flat list", input: "Depth([1, 2, 3])", expected: "2"},
+		{name: "Depth of matrix", input: "Depth([[1, 2], [3, 4]])", expected: "3"},
+		{name: "Depth of ragged list", input: "Depth([a, [b]])", expected: "3"},
+		{name: "Depth of empty list", input: "Depth([])", expected: "1"},
+	}
+	runTestCases(t, tests)
+}
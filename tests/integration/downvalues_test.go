@@ -0,0 +1,52 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestDownValues(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "DownValues of an undefined symbol",
+			input:    "DownValues(f)",
+			expected: "List()",
+		},
+		{
+			name:     "DownValues are ordered by specificity",
+			input:    "f(n_) := n * f(n - 1); f(0) := 1; DownValues(f)",
+			expected: "List(RuleDelayed(HoldPattern(f(0)), 1), RuleDelayed(HoldPattern(f(Pattern(n, Blank()))), Times(n, f(Subtract(n, 1)))))",
+		},
+		{
+			name:     "DownValues include values from Set",
+			input:    "g(1) = 1 + 1; DownValues(g)",
+			expected: "List(RuleDelayed(HoldPattern(g(1)), 2))",
+		},
+		{
+			name:     "DownValues of a builtin does not include Go definitions",
+			input:    "DownValues(Plus)",
+			expected: "List()",
+		},
+		{
+			name:     "Setting DownValues replaces the definitions",
+			input:    "f(x_) := 1; DownValues(f) = [HoldPattern(f(0)) => 10, HoldPattern(f(x_)) => x + 1]; [f(0), f(5), Length(DownValues(f))]",
+			expected: "List(10, 6, 2)",
+		},
+		{
+			name:     "DownValues round trip",
+			input:    "f(0) := 1; f(n_) := n * f(n - 1); d = DownValues(f); Clear(f); DownValues(f) = d; f(5)",
+			expected: "120",
+		},
+		{
+			name:      "DownValues must be rules for the symbol",
+			input:     "DownValues(f) = [HoldPattern(g(0)) => 1]",
+			errorType: "ArgumentError",
+		},
+		{
+			name:      "DownValues of a Protected symbol cannot be set",
+			input:     "DownValues(Plus) = []",
+			errorType: "Protected",
+		},
+	}
+
+	runTestCases(t, tests)
+}
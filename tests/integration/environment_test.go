@@ -0,0 +1,78 @@
+package integration
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/client9/cardinal"
+	"github.com/client9/cardinal/core"
+)
+
+func TestEnvironment(t *testing.T) {
+	t.Setenv("CARDINAL_TEST_VAR", "hello")
+
+	tests := []TestCase{
+		{
+			name:     "Environment variable is set",
+			input:    "Environment(\"CARDINAL_TEST_VAR\")",
+			expected: "\"hello\"",
+		},
+		{
+			name:     "Environment variable is not set",
+			input:    "Environment(\"CARDINAL_TEST_UNSET_VAR\")",
+			expected: "Missing(\"NotFound\")",
+		},
+		{
+			name:     "DirectoryName",
+			input:    "DirectoryName(FileNameJoin([\"a\", \"b\", \"c.txt\"]))",
+			expected: "\"" + filepath.Join("a", "b") + "\"",
+		},
+		{
+			name:     "DirectoryName without a directory",
+			input:    "DirectoryName(\"c.txt\")",
+			expected: "\"\"",
+		},
+		{
+			name:      "FileNameJoin with a non-string",
+			input:     "FileNameJoin([\"a\", 1])",
+			errorType: "TypeError",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestEnvironmentSafeMode(t *testing.T) {
+	t.Setenv("CARDINAL_TEST_VAR", "hello")
+
+	e := cardinal.NewEvaluator()
+	e.SetSafeMode(true)
+	e.SetCommandLine([]string{"cardinal", "script.cardinal"})
+
+	expr, err := e.ParseString("Environment(\"CARDINAL_TEST_VAR\")")
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := e.Evaluate(expr)
+	if errExpr, ok := core.AsError(result); !ok || errExpr.StackTrace()[0].ErrorType != "SecurityError" {
+		t.Errorf("expected SecurityError in safe mode, got %s", result)
+	}
+
+	expr, _ = e.ParseString("$CommandLine")
+	if result := e.Evaluate(expr); result.String() != "$CommandLine" {
+		t.Errorf("expected $CommandLine to be unset in safe mode, got %s", result)
+	}
+}
+
+func TestCommandLine(t *testing.T) {
+	e := cardinal.NewEvaluator()
+	e.SetCommandLine([]string{"cardinal", "script.cardinal"})
+
+	expr, err := e.ParseString("$CommandLine")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := e.Evaluate(expr); result.String() != `List("cardinal", "script.cardinal")` {
+		t.Errorf("expected command line list, got %s", result)
+	}
+}
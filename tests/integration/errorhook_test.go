@@ -0,0 +1,54 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/client9/cardinal"
+	"github.com/client9/cardinal/core"
+)
+
+func TestErrorHook(t *testing.T) {
+	e := cardinal.NewEvaluator()
+	var got []string
+	e.SetErrorHook(func(err core.ErrorExpr) {
+		got = append(got, err.ErrorType)
+	})
+
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"1 + 2", nil},
+		{"1 / 0", []string{"DivisionByZero"}},
+		{"f(x_) := 1 / x; Plus(1, f(0))", []string{"DivisionByZero"}},
+		{"Length(1, 2, 3)", nil},
+		{"Pause(-1)", []string{"ArgumentError"}},
+		{"Catch(Throw(1))", nil},
+	}
+	for _, tt := range tests {
+		got = nil
+		expr, err := e.ParseString(tt.input)
+		if err != nil {
+			t.Fatalf("%q: %v", tt.input, err)
+		}
+		e.Evaluate(expr)
+		if len(got) != len(tt.expected) {
+			t.Errorf("%q: expected %v, got %v", tt.input, tt.expected, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.expected[i] {
+				t.Errorf("%q: expected %v, got %v", tt.input, tt.expected, got)
+			}
+		}
+	}
+
+	// removing the hook stops reporting
+	got = nil
+	e.SetErrorHook(nil)
+	expr, _ := e.ParseString("1 / 0")
+	e.Evaluate(expr)
+	if len(got) != 0 {
+		t.Errorf("expected no errors after removing the hook, got %v", got)
+	}
+}
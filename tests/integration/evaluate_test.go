@@ -48,9 +48,29 @@ func TestEvaluateSpecialForm(t *testing.T) {
 			expected: "Hold(Plus(1, 2))", // Hold prevents evaluation even inside Evaluate
 		},
 		{
-			name:     "hold prevents evaluate from working",
+			name:     "evaluate overrides hold",
 			input:    "Hold(Evaluate(Plus(1, 2)))",
-			expected: "Hold(Evaluate(Plus(1, 2)))", // Hold prevents evaluation entirely
+			expected: "Hold(3)",
+		},
+		{
+			name:     "evaluate selects one held argument",
+			input:    "Hold(Evaluate(1 + 1), 2 + 2)",
+			expected: "Hold(2, Plus(2, 2))",
+		},
+		{
+			name:     "evaluate only overrides hold at the top of an argument",
+			input:    "Hold(f(Evaluate(1 + 1)))",
+			expected: "Hold(f(Evaluate(Plus(1, 1))))",
+		},
+		{
+			name:     "evaluate in a HoldFirst argument",
+			input:    "SetAttributes(g, HoldFirst); g(Evaluate(1 + 1), 2 + 2)",
+			expected: "g(2, 4)",
+		},
+		{
+			name:     "evaluate in a HoldRest argument",
+			input:    "SetAttributes(h, HoldRest); h(1 + 1, Evaluate(2 + 2), 3 + 3)",
+			expected: "h(2, 4, Plus(3, 3))",
 		},
 
 		// Error propagation
@@ -75,7 +95,7 @@ func TestEvaluateSpecialForm(t *testing.T) {
 		},
 		{
 			name:     "evaluate variable binding",
-			input:    "Evaluate(Set(y, 10)); Times(y, 2))",
+			input:    "Evaluate(Set(y, 10)); Times(y, 2)",
 			expected: "20",
 		},
 
@@ -113,6 +133,15 @@ func TestEvaluateWithAttributes(t *testing.T) {
 	runTestCases(t, tests)
 }
 
+func TestEvaluateStringTrailingInput(t *testing.T) {
+	// only one expression is evaluated, anything after it is a parse error
+	for _, input := range []string{"f(1))", "x = 1\nx + 1", "1 2"} {
+		if result := evaluateString(input); result != "ERROR" {
+			t.Errorf("expected a parse error for %q, got %s", input, result)
+		}
+	}
+}
+
 /*
 func TestEvaluateStackBehavior(t *testing.T) {
 	// Test that Evaluate properly manages the evaluation stack
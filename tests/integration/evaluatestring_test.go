@@ -0,0 +1,86 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/client9/cardinal"
+	"github.com/client9/cardinal/core"
+)
+
+func TestEvaluatorEvaluateString(t *testing.T) {
+	e := cardinal.NewEvaluator()
+
+	result, err := e.EvaluateString("x = 2; x^10")
+	if err != nil {
+		t.Fatalf("EvaluateString: %v", err)
+	}
+	if result.String() != "1024" {
+		t.Errorf("Expected 1024, got %s", result)
+	}
+
+	// the context is kept between calls
+	result, _ = e.EvaluateString("x + 1")
+	if result.String() != "3" {
+		t.Errorf("Expected 3, got %s", result)
+	}
+
+	// a parse error is an error, with no result
+	result, err = e.EvaluateString("Plus(1, 2")
+	if err == nil || result != nil {
+		t.Errorf("Expected a parse error, got %v, %v", result, err)
+	}
+	if !core.IsIncomplete(err) {
+		t.Errorf("Expected an incomplete input error, got %v", err)
+	}
+
+	// an evaluation error is a result
+	result, err = e.EvaluateString("1/0")
+	if err != nil {
+		t.Fatalf("Evaluation errors should not be Go errors: %v", err)
+	}
+	if errExpr, ok := core.AsError(result); !ok || errExpr.StackTrace()[0].ErrorType != "DivisionByZero" {
+		t.Errorf("Expected a DivisionByZero result, got %s", result)
+	}
+}
+
+func TestEvaluatorEvaluateAll(t *testing.T) {
+	tests := []TestCase{
+		{name: "Statements on lines", input: "a = 1\nb = a + 1\na + b", expected: "3"},
+		{name: "Statements with semicolons", input: "a = 1; b = 2;\na * 10 + b", expected: "12"},
+		{name: "Multi-line statement", input: "f(x_) :=\n  x^2\nf(3)", expected: "9"},
+		{name: "Trailing semicolon", input: "a = 1\na;", expected: "Null"},
+		{name: "Empty program", input: "", expected: "Null"},
+		{name: "Stops at an error", input: "a = 1\n1/0\na = 2", errorType: "DivisionByZero"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := cardinal.NewEvaluator()
+			result, err := e.EvaluateAll(tt.input)
+			if err != nil {
+				t.Fatalf("EvaluateAll(%q): %v", tt.input, err)
+			}
+			if tt.errorType != "" {
+				if errExpr, ok := core.AsError(result); !ok || errExpr.StackTrace()[0].ErrorType != tt.errorType {
+					t.Errorf("Expected %s, got %s", tt.errorType, result)
+				}
+				if a, _ := e.EvaluateString("a"); a.String() != "1" {
+					t.Errorf("Statements after the error should not run, a is %s", a)
+				}
+				return
+			}
+			if result.String() != tt.expected {
+				t.Errorf("EvaluateAll(%q) = %s, want %s", tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	e := cardinal.NewEvaluator()
+	for _, bad := range []string{"a = 1\nb = (2", "1 + 2)\n3", "x = 1\n@"} {
+		if result, err := e.EvaluateAll(bad); err == nil {
+			t.Errorf("EvaluateAll(%q) = %s, want a parse error", bad, result)
+		}
+	}
+	if x, _ := e.EvaluateString("x"); x.String() != "x" {
+		t.Errorf("Nothing should run when the program does not parse, x is %s", x)
+	}
+}
@@ -0,0 +1,47 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestExcept(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Cases with Except",
+			input:    "Cases([1, a, 2, b], Except(_Integer))",
+			expected: "List(a, b)",
+		},
+		{
+			name:     "Count with Except",
+			input:    "Count([1, a, 2, b, \"c\"], Except(_Integer))",
+			expected: "3",
+		},
+		{
+			name:     "Except with a pattern to match",
+			input:    "Cases([0, 1, a, 2, 0], Except(0, _Integer))",
+			expected: "List(1, 2)",
+		},
+		{
+			name:     "Except a literal",
+			input:    "[MatchQ(a, Except(b)), MatchQ(b, Except(b))]",
+			expected: "List(True, False)",
+		},
+		{
+			name:     "Except with a condition",
+			input:    "Cases([1, 5, 10], Except(x_ /; x > 3))",
+			expected: "List(1)",
+		},
+		{
+			name:     "Except binds the pattern to match",
+			input:    "Cases([0, 3, 4], Except(0, x_Integer) : x * 2)",
+			expected: "List(6, 8)",
+		},
+		{
+			name:     "Except in a definition",
+			input:    "g(Except(0, x_)) := 10 / x; g(0) := Infinity; [g(2), g(0)]",
+			expected: "List(5, Infinity)",
+		},
+	}
+
+	runTestCases(t, tests)
+}
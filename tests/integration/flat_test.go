@@ -0,0 +1,67 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestFlat(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Nested arguments are flattened",
+			input:    "SetAttributes(f, Flat); f(a, f(b, c), d)",
+			expected: "f(a, b, c, d)",
+		},
+		{
+			name:     "Arguments exposed by flattening are flattened",
+			input:    "SetAttributes(f, Flat); f(f(a, f(b)))",
+			expected: "f(a, b)",
+		},
+		{
+			name:     "Deep nesting",
+			input:    "SetAttributes(f, Flat); f(f(f(f(a))), f(b, f(c, f(d, f(e)))))",
+			expected: "f(a, b, c, d, e)",
+		},
+		{
+			name:     "Empty arguments disappear",
+			input:    "SetAttributes(f, Flat); f(f(), a, f(f()))",
+			expected: "f(a)",
+		},
+		{
+			name:     "Other heads are kept",
+			input:    "SetAttributes(f, Flat); f(g(f(a)), f(b))",
+			expected: "f(g(f(a)), b)",
+		},
+		{
+			name:     "Flat and OneIdentity collapse to the argument",
+			input:    "SetAttributes(f, [Flat, OneIdentity]); f(f(a))",
+			expected: "a",
+		},
+		{
+			name:     "Flat and OneIdentity collapse deep nesting",
+			input:    "SetAttributes(f, [Flat, OneIdentity]); f(f(f(f(a))))",
+			expected: "a",
+		},
+		{
+			name:     "Flat and OneIdentity keep several arguments",
+			input:    "SetAttributes(f, [Flat, OneIdentity]); f(f(a), f(f(b)))",
+			expected: "f(a, b)",
+		},
+		{
+			name:     "Flat and OneIdentity with no arguments",
+			input:    "SetAttributes(f, [Flat, OneIdentity]); f(f())",
+			expected: "f()",
+		},
+		{
+			name:     "Definitions see the flattened arguments",
+			input:    "SetAttributes(f, Flat); f(x_, y_, z_) := [x, y, z]; f(a, f(f(b), c))",
+			expected: "List(a, b, c)",
+		},
+		{
+			name:     "Held expressions are not flattened",
+			input:    "SetAttributes(f, Flat); Hold(f(f(a)))",
+			expected: "Hold(f(f(a)))",
+		},
+	}
+
+	runTestCases(t, tests)
+}
@@ -31,6 +31,16 @@ func TestBlockBasic(t *testing.T) {
 			input:    `Block(List(Set(localVar, 42)), localVar)`,
 			expected: `42`,
 		},
+		{
+			name:     "Block skips a variable that is not a symbol",
+			input:    `Block([5], a)`,
+			expected: `a`,
+		},
+		{
+			name:     "Block skips a variable after an assignment",
+			input:    `Block([x = 1, 3], x)`,
+			expected: `1`,
+		},
 	}
 
 	runTestCases(t, tests)
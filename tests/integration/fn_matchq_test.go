@@ -161,3 +161,55 @@ func TestMatchQRegressionCases(t *testing.T) {
 
 	runTestCases(t, tests)
 }
+
+func TestMatchQBasics(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Integer matches _Integer",
+			input:    "MatchQ(3, _Integer)",
+			expected: "True",
+		},
+		{
+			name:     "List does not match _Integer",
+			input:    "MatchQ([1, 2], _Integer)",
+			expected: "False",
+		},
+		{
+			name:     "Head pattern",
+			input:    "MatchQ(f(1, 2), f(_, _))",
+			expected: "True",
+		},
+		{
+			name:     "Head pattern with wrong head",
+			input:    "MatchQ(g(1, 2), f(_, _))",
+			expected: "False",
+		},
+		{
+			name:     "Pattern variables are not assigned",
+			input:    "MatchQ(5, x_); x",
+			expected: "x",
+		},
+		{
+			name:     "Operator form",
+			input:    "MatchQ(_Integer)(3)",
+			expected: "True",
+		},
+		{
+			name:     "Select with the operator form",
+			input:    "Select([1, a, 2, \"b\"], MatchQ(_Integer))",
+			expected: "List(1, 2)",
+		},
+		{
+			name:     "Select with a count",
+			input:    "Select([1, a, 2, b, 3], MatchQ(_Integer), 2)",
+			expected: "List(1, 2)",
+		},
+		{
+			name:     "Select keeps the head",
+			input:    "Select(f(1, a, 2), MatchQ(_Symbol))",
+			expected: "f(a)",
+		},
+	}
+
+	runTestCases(t, tests)
+}
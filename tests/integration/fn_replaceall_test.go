@@ -118,3 +118,50 @@ func TestReplaceAllWithRules(t *testing.T) {
 
 	runTestCases(t, tests)
 }
+
+func TestReplaceAllOperator(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "ReplaceAll operator on list elements",
+			input:    `[1, 2, 3] /. 2 : 20`,
+			expected: `List(1, 20, 3)`,
+		},
+		{
+			name:     "ReplaceAll operator with pattern variable",
+			input:    `f(3) /. f(x_) : x^2`,
+			expected: `9`,
+		},
+		{
+			name:     "ReplaceAll operator with pattern variable inside list",
+			input:    `[f(1), g(2), f(3)] /. f(x_) : x + 10`,
+			expected: `List(11, g(2), 13)`,
+		},
+		{
+			name:     "ReplaceAll operator with list of rules",
+			input:    `[a, b, c] /. [a : 1, c : 3]`,
+			expected: `List(1, b, 3)`,
+		},
+		{
+			name:     "ReplaceAll operator first matching rule wins",
+			input:    `[a, b] /. [a : 1, a : 2]`,
+			expected: `List(1, b)`,
+		},
+		{
+			name:     "ReplaceAll operator does not rescan replacement",
+			input:    `f(f(x)) /. f(y_) : y`,
+			expected: `f(x)`,
+		},
+		{
+			name:     "ReplaceAll operator with RuleDelayed",
+			input:    `[1, 2, 3] /. x_Integer => x * 2`,
+			expected: `List(2, 4, 6)`,
+		},
+		{
+			name:     "ReplaceAll operator chained",
+			input:    `x /. x : y /. y : z`,
+			expected: `z`,
+		},
+	}
+
+	runTestCases(t, tests)
+}
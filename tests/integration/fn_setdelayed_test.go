@@ -30,3 +30,35 @@ func TestSetDelayed(t *testing.T) {
 
 	runTestCases(t, tests)
 }
+
+func TestDefinitionDispatch(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Literal cases before the general rule",
+			input:    `fib(0) := 0; fib(1) := 1; fib(n_Integer) := fib(n - 1) + fib(n - 2); [fib(0), fib(1), fib(15)]`,
+			expected: `List(0, 1, 610)`,
+		},
+		{
+			name:     "Head constraint",
+			input:    `g(n_Integer) := "int"; g(x_Real) := "real"; g(x_) := "other"; [g(1), g(1.5), g(a), g(1, 2)]`,
+			expected: `List("int", "real", "other", g(1, 2))`,
+		},
+		{
+			name:     "Repeated variable",
+			input:    `same(x_, x_) := True; [same(1, 1), same(1, 2), same(a, a)]`,
+			expected: `List(True, same(1, 2), True)`,
+		},
+		{
+			name:     "Literal string argument",
+			input:    `h("a", x_) := x; h(_, _) := 0; [h("a", 5), h("b", 5)]`,
+			expected: `List(5, 0)`,
+		},
+		{
+			name:     "Simple and complex patterns together",
+			input:    `k(x_Integer) := 1; k(x__) := 2; k(x_Real /; x > 10) := 3; [k(5), k(1, 2), k(20.5), k(a)]`,
+			expected: `List(1, 2, 3, 2)`,
+		},
+	}
+
+	runTestCases(t, tests)
+}
@@ -158,6 +158,26 @@ func TestFunction_Scoping(t *testing.T) {
 			input:    "z = 20;Function($1 + z)(5)",
 			expected: "25",
 		},
+		{
+			name:     "Inner parameter shadows outer parameter",
+			input:    "Function(x, Function(x, x))(1)(2)",
+			expected: "2",
+		},
+		{
+			name:     "Inner function sees outer parameter",
+			input:    "Function(x, Function(y, x + y))(1)(2)",
+			expected: "3",
+		},
+		{
+			name:     "Inner slots belong to the inner function",
+			input:    "(Map(($ + 1 &), [$, 10]) &)(1)",
+			expected: "List(2, 11)",
+		},
+		{
+			name:     "Slots are not replaced in a named inner function",
+			input:    "Function([x], Function(y, x + y))(1)(2)",
+			expected: "3",
+		},
 	}
 	runTestCases(t, tests)
 }
@@ -189,6 +209,42 @@ func TestFunction_NestedFunctions(t *testing.T) {
 	runTestCases(t, tests)
 }
 
+func TestFunction_Application(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Pure function with &",
+			input:    "($ + 1 &)(5)",
+			expected: "6",
+		},
+		{
+			name:     "Named parameter",
+			input:    "Function(x, x * x)(4)",
+			expected: "16",
+		},
+		{
+			name:     "Named parameter list",
+			input:    "Function([x, y], x - y)(5, 3)",
+			expected: "2",
+		},
+		{
+			name:     "Slot sequence",
+			input:    "(f($$) &)(1, 2, 3)",
+			expected: "f(1, 2, 3)",
+		},
+		{
+			name:     "Extra arguments are ignored",
+			input:    "Function(x, x)(1, 2)",
+			expected: "1",
+		},
+		{
+			name:      "Too few arguments",
+			input:     "Function([x, y], x)(1)",
+			errorType: "ArgumentError",
+		},
+	}
+	runTestCases(t, tests)
+}
+
 func TestFunction_AmpersandSyntax(t *testing.T) {
 	tests := []TestCase{
 		// Basic & syntax tests
@@ -0,0 +1,26 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestHead(t *testing.T) {
+	tests := []TestCase{
+		{name: "Head of integer", input: "Head(5)", expected: "Integer"},
+		{name: "Head of big integer", input: "Head(100000000000000000000000000)", expected: "Integer"},
+		{name: "Head of real", input: "Head(1.5)", expected: "Real"},
+		{name: "Head of rational", input: "Head(1/2)", expected: "Rational"},
+		{name: "Head of string", input: "Head(\"x\")", expected: "String"},
+		{name: "Head of symbol", input: "Head(x)", expected: "Symbol"},
+		{name: "Head of boolean", input: "Head(True)", expected: "Symbol"},
+		{name: "Head of rune", input: "Head(First(\"abc\"))", expected: "Rune"},
+		{name: "Head of association", input: "Head({a: 1})", expected: "Association"},
+		{name: "Head of list", input: "Head([1, 2])", expected: "List"},
+		{name: "Head of compound", input: "Head(f(a, b))", expected: "f"},
+		{name: "Head of compound head", input: "Head(f(a)(b))", expected: "f(a)"},
+		{name: "Head is evaluated argument", input: "Head(Plus(1, 2))", expected: "Integer"},
+		{name: "Head is a symbol", input: "SameQ(Head(5), Integer)", expected: "True"},
+		{name: "Head of Head", input: "Head(Head(5))", expected: "Symbol"},
+	}
+	runTestCases(t, tests)
+}
@@ -0,0 +1,43 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestHoldForm(t *testing.T) {
+	tests := []TestCase{
+		{name: "HoldForm prints without the wrapper", input: "HoldForm(1 + 1)", expected: "Plus(1, 1)"},
+		{name: "HoldForm holds symbols", input: "x = 3; HoldForm(x)", expected: "x"},
+		{name: "HoldForm is kept", input: "SameQ(HoldForm(2), 2)", expected: "False"},
+		{name: "HoldForm head", input: "Head(HoldForm(1 + 1))", expected: "HoldForm"},
+		{name: "HoldForm OutputForm", input: "ToString(HoldForm(1 + 1))", expected: `"Plus(1, 1)"`},
+		{name: "HoldForm InputForm keeps the wrapper", input: "InputForm(HoldForm(1 + 1))", expected: `"HoldForm(1 + 1)"`},
+	}
+	runTestCases(t, tests)
+}
+
+func TestReleaseHold(t *testing.T) {
+	tests := []TestCase{
+		{name: "Hold", input: "ReleaseHold(Hold(1 + 1))", expected: "2"},
+		{name: "HoldForm", input: "ReleaseHold(HoldForm(1 + 1))", expected: "2"},
+		{name: "inside a list", input: "ReleaseHold([Hold(1 + 1), Hold(2 + 3)])", expected: "List(2, 5)"},
+		{name: "only the outermost layer", input: "ReleaseHold(Hold(Hold(1 + 1)))", expected: "Hold(Plus(1, 1))"},
+		{name: "held symbol", input: "x = 3; ReleaseHold(Hold(x))", expected: "3"},
+		{name: "no wrapper", input: "ReleaseHold(5)", expected: "5"},
+		{name: "several held expressions", input: "ReleaseHold(Hold(a, b))", expected: "Hold(a, b)"},
+	}
+	runTestCases(t, tests)
+}
+
+func TestUnevaluated(t *testing.T) {
+	tests := []TestCase{
+		{name: "argument is not evaluated", input: "Length(Unevaluated(1 + 1))", expected: "2"},
+		{name: "argument is evaluated without it", input: "Length(1 + 1)", expected: "0"},
+		{name: "passed to a head", input: "Head(Unevaluated(1 + 1))", expected: "Plus"},
+		{name: "user definition", input: "g(x_) := Hold(x); g(Unevaluated(1 + 1))", expected: "Hold(Plus(1, 1))"},
+		{name: "kept when nothing matches", input: "f(Unevaluated(1 + 1))", expected: "f(Unevaluated(Plus(1, 1)))"},
+		{name: "kept inside a held argument", input: "Hold(Unevaluated(1 + 1))", expected: "Hold(Unevaluated(Plus(1, 1)))"},
+		{name: "on its own", input: "Unevaluated(1 + 1)", expected: "Unevaluated(Plus(1, 1))"},
+	}
+	runTestCases(t, tests)
+}
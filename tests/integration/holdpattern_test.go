@@ -0,0 +1,57 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestHoldPattern(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "HoldPattern is not evaluated",
+			input:    "HoldPattern(1 + 1)",
+			expected: "HoldPattern(Plus(1, 1))",
+		},
+		{
+			name:     "Rule with HoldPattern keeps its left side",
+			input:    "HoldPattern(1 + 1) : x",
+			expected: "Rule(HoldPattern(Plus(1, 1)), x)",
+		},
+		{
+			name:     "Without HoldPattern the left side is evaluated",
+			input:    "(1 + 1) : x",
+			expected: "Rule(2, x)",
+		},
+		{
+			name:     "HoldPattern matches the unevaluated form",
+			input:    "Hold(1 + 1) /. HoldPattern(1 + 1) : x",
+			expected: "Hold(x)",
+		},
+		{
+			name:     "HoldPattern does not match the evaluated form",
+			input:    "MatchQ(2, HoldPattern(1 + 1))",
+			expected: "False",
+		},
+		{
+			name:     "HoldPattern with pattern variables",
+			input:    "Hold(a + b) /. HoldPattern(x_ + y_) : [x, y]",
+			expected: "Hold(List(a, b))",
+		},
+		{
+			name:     "HoldPattern on the left side of a definition",
+			input:    "HoldPattern(g(x_)) := x + 1; g(2)",
+			expected: "3",
+		},
+		{
+			name:     "HoldPattern on the left side of an assignment",
+			input:    "HoldPattern(g(1)) = 5; [g(1), g(2), DownValues(g)]",
+			expected: "List(5, g(2), List(RuleDelayed(HoldPattern(g(1)), 5)))",
+		},
+		{
+			name:     "HoldPattern around a symbol in an assignment",
+			input:    "HoldPattern(y) = 3; y",
+			expected: "3",
+		},
+	}
+
+	runTestCases(t, tests)
+}
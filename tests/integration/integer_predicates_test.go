@@ -0,0 +1,93 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestEvenOddQ(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "EvenQ",
+			input:    `Map(EvenQ, [0, 1, 2, -3, -4])`,
+			expected: "List(True, False, True, False, True)",
+		},
+		{
+			name:     "OddQ",
+			input:    `Map(OddQ, [0, 1, 2, -3, -4])`,
+			expected: "List(False, True, False, True, False)",
+		},
+		{
+			name:     "Non-integers are neither even nor odd",
+			input:    `[EvenQ(2.0), OddQ(1.0), EvenQ(x), OddQ("1"), EvenQ(1/2)]`,
+			expected: "List(False, False, False, False, False)",
+		},
+		{
+			name:     "Big integers",
+			input:    `[EvenQ(100000000000000000000), OddQ(100000000000000000001)]`,
+			expected: "List(True, True)",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestPrimeQ(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Small numbers",
+			input:    `Select([0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 25, 29, 49, 97], PrimeQ)`,
+			expected: "List(2, 3, 5, 7, 11, 13, 29, 97)",
+		},
+		{
+			name:     "Carmichael numbers are composite",
+			input:    `Map(PrimeQ, [561, 1105, 1729, 2465, 41041, 825265, 3215031751])`,
+			expected: "List(False, False, False, False, False, False, False)",
+		},
+		{
+			name:     "Negative numbers",
+			input:    `Map(PrimeQ, [-2, -7, -9, -1])`,
+			expected: "List(True, True, False, False)",
+		},
+		{
+			name:     "Large machine integers",
+			input:    `[PrimeQ(2305843009213693951), PrimeQ(9223372036854775783), PrimeQ(9223372036854775807)]`,
+			expected: "List(True, True, False)",
+		},
+		{
+			name:     "Big integers",
+			input:    `[PrimeQ(618970019642690137449562111), PrimeQ(618970019642690137449562113)]`,
+			expected: "List(True, False)",
+		},
+		{
+			name:     "Negative big integers",
+			input:    `[PrimeQ(-Plus(2^89, -1)), PrimeQ(-618970019642690137449562113)]`,
+			expected: "List(True, False)",
+		},
+		{
+			name:     "Non-integers are not prime",
+			input:    `[PrimeQ(7.0), PrimeQ(x), PrimeQ("7")]`,
+			expected: "List(False, False, False)",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestDivisible(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Divisible",
+			input:    `[Divisible(12, 4), Divisible(12, 5), Divisible(-12, 4), Divisible(0, 7)]`,
+			expected: "List(True, False, True, True)",
+		},
+		{
+			name:     "Big integers",
+			input:    `[Divisible(100000000000000000000, 5), Divisible(100000000000000000001, 2)]`,
+			expected: "List(True, False)",
+		},
+		{
+			name:      "Division by zero",
+			input:     `Divisible(3, 0)`,
+			errorType: "DivisionByZero",
+		},
+	}
+	runTestCases(t, tests)
+}
@@ -0,0 +1,119 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestExportStringJSON(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Atoms",
+			input:    `ExportString([1, -2.5, 3.0, "a\"b", True, False, Null], "JSON")`,
+			expected: `"[1,-2.5,3.0,"a\"b",true,false,null]"`,
+		},
+		{
+			name:     "Nested lists",
+			input:    `ExportString([[1, 2], [], [[3]]], "JSON")`,
+			expected: `"[[1,2],[],[[3]]]"`,
+		},
+		{
+			name:     "Associations are objects in key order",
+			input:    `ExportString({"b": 1, "a": [2, {"c": "d"}]}, "JSON")`,
+			expected: `"{"b":1,"a":[2,{"c":"d"}]}"`,
+		},
+		{
+			name:     "Non-string keys are written in InputForm",
+			input:    `ExportString({x: 1, 2: 3}, "JSON")`,
+			expected: `"{"x":1,"2":3}"`,
+		},
+		{
+			name:     "Symbolic expressions are tagged objects",
+			input:    `ExportString(Hold(Plus(1, 2)), "JSON")`,
+			expected: `"{"head":"Hold","args":[{"head":"Plus","args":[1,2]}]}"`,
+		},
+		{
+			name:     "Symbols are tagged objects",
+			input:    `ExportString(f(x), "JSON")`,
+			expected: `"{"head":"f","args":[{"symbol":"x"}]}"`,
+		},
+		{
+			name:     "Rationals",
+			input:    `ExportString(1/3, "JSON")`,
+			expected: `"{"head":"Rational","args":[1,3]}"`,
+		},
+		{
+			name:     "Big integers keep every digit",
+			input:    `ExportString(2^100, "JSON")`,
+			expected: `"1267650600228229401496703205376"`,
+		},
+		{
+			name:      "Unknown format",
+			input:     `ExportString(1, "XML")`,
+			errorType: "ExportError",
+		},
+		{
+			name:      "Infinity has no JSON form",
+			input:     `ExportString(1.0 * 10^300 * 10^300, "JSON")`,
+			errorType: "ExportError",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestImportStringJSON(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Scalars",
+			input:    `ImportString("[1, -2.5, 1e3, \"s\", true, false, null]", "JSON")`,
+			expected: `List(1, -2.5, 1000.0, "s", True, False, Null)`,
+		},
+		{
+			name:     "Objects are associations in document order",
+			input:    `ImportString("{\"b\": 1, \"a\": {\"c\": [2]}}", "JSON")`,
+			expected: `Association(Rule("b", 1), Rule("a", Association(Rule("c", List(2)))))`,
+		},
+		{
+			name:     "Values are usable",
+			input:    `ImportString("{\"xs\": [1, 2, 3]}", "JSON")["xs"]`,
+			expected: `List(1, 2, 3)`,
+		},
+		{
+			name:     "Large integers stay exact",
+			input:    `ImportString("123456789012345678901234567890", "JSON") + 1`,
+			expected: `123456789012345678901234567891`,
+		},
+		{
+			name:     "Round trip a document",
+			input:    `doc = "{\"name\":\"cardinal\",\"tags\":[\"cas\",\"go\"],\"n\":3,\"ratio\":0.5,\"nested\":{\"ok\":true,\"none\":null,\"empty\":[]}}"; ExportString(ImportString(doc, "JSON"), "JSON") === doc`,
+			expected: `True`,
+		},
+		{
+			name:     "Round trip an expression",
+			input:    `data = {"a": [1, 2.0, "x"], "b": {"c": False, "d": Null}}; ImportString(ExportString(data, "JSON"), "JSON") === data`,
+			expected: `True`,
+		},
+		{
+			name:      "Unclosed array",
+			input:     `ImportString("[1, 2", "JSON")`,
+			errorType: "ImportError",
+		},
+		{
+			name:      "Missing colon",
+			input:     `ImportString("{\"a\" 1}", "JSON")`,
+			errorType: "ImportError",
+		},
+		{
+			name:      "Trailing data",
+			input:     `ImportString("[1] [2]", "JSON")`,
+			errorType: "ImportError",
+		},
+		{
+			name:      "Unknown format",
+			input:     `ImportString("1", "CSV")`,
+			errorType: "ImportError",
+		},
+	}
+
+	runTestCases(t, tests)
+}
@@ -0,0 +1,88 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestKeyDrop(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Drop several keys at once",
+			input:    "KeyDrop({a: 1, b: 2, c: 3}, [a, c])",
+			expected: "Association(Rule(b, 2))",
+		},
+		{
+			name:     "Drop a single key",
+			input:    "KeyDrop({a: 1, b: 2}, a)",
+			expected: "Association(Rule(b, 2))",
+		},
+		{
+			name:     "Missing keys are ignored",
+			input:    "KeyDrop({a: 1}, [z])",
+			expected: "Association(Rule(a, 1))",
+		},
+		{
+			name:     "The original is unchanged",
+			input:    "x = {a: 1, b: 2}; KeyDrop(x, a); x",
+			expected: "Association(Rule(a, 1), Rule(b, 2))",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestKeyExistsQ(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Key exists",
+			input:    `KeyExistsQ({"a": 1}, "a")`,
+			expected: "True",
+		},
+		{
+			name:     "Key does not exist",
+			input:    `KeyExistsQ({"a": 1}, "b")`,
+			expected: "False",
+		},
+		{
+			name:     "Key with a Null value exists",
+			input:    "KeyExistsQ({a: Null}, a)",
+			expected: "True",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestAssociateTo(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Append adds a key",
+			input:    "Append({a: 1}, b: 2)",
+			expected: "Association(Rule(a, 1), Rule(b, 2))",
+		},
+		{
+			name:     "Append overwrites an existing key in place",
+			input:    "Append({a: 1, b: 2}, a: 5)",
+			expected: "Association(Rule(a, 5), Rule(b, 2))",
+		},
+		{
+			name:     "Append a list of rules",
+			input:    "Append({a: 1}, [a: 0, c: 3])",
+			expected: "Association(Rule(a, 0), Rule(c, 3))",
+		},
+		{
+			name:     "AssociateTo updates the variable",
+			input:    "x = {a: 1}; AssociateTo(x, a: 2); AssociateTo(x, [b: 3]); x",
+			expected: "Association(Rule(a, 2), Rule(b, 3))",
+		},
+		{
+			name:      "AssociateTo needs an association",
+			input:     "x = 1; AssociateTo(x, a: 2)",
+			errorType: "TypeError",
+		},
+		{
+			name:      "AssociateTo needs rules",
+			input:     "x = {}; AssociateTo(x, 2)",
+			errorType: "ArgumentError",
+		},
+	}
+	runTestCases(t, tests)
+}
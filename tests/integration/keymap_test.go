@@ -0,0 +1,79 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestKeyMap(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Empty association",
+			input:    "KeyMap(f, {})",
+			expected: "Association()",
+		},
+		{
+			name:     "Symbolic function",
+			input:    "KeyMap(f, {a: 1, b: 2})",
+			expected: "Association(Rule(f(a), 1), Rule(f(b), 2))",
+		},
+		{
+			name:     "Pure function on keys",
+			input:    "KeyMap(StringLength, {\"ab\": 1, \"abc\": 2})",
+			expected: "Association(Rule(2, 1), Rule(3, 2))",
+		},
+		{
+			name:     "Collision keeps last value",
+			input:    "KeyMap(StringLength, {\"ab\": 1, \"cd\": 2, \"efg\": 3})",
+			expected: "Association(Rule(2, 2), Rule(3, 3))",
+		},
+		{
+			name:     "Collision with combiner",
+			input:    "KeyMap(StringLength, {\"ab\": 1, \"cd\": 2, \"efg\": 3}, Function(v, Apply(Plus, v)))",
+			expected: "Association(Rule(2, 3), Rule(3, 3))",
+		},
+		{
+			name:     "Combiner receives values in order",
+			input:    "KeyMap(StringLength, {\"ab\": 1, \"cd\": 2, \"ef\": 3}, g)",
+			expected: "Association(Rule(2, g(List(1, 2, 3))))",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestRenameKeys(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Single rename",
+			input:    "RenameKeys({a: 1, b: 2}, a: x)",
+			expected: "Association(Rule(x, 1), Rule(b, 2))",
+		},
+		{
+			name:     "List of renames",
+			input:    "RenameKeys({a: 1, b: 2, c: 3}, [a: x, c: z])",
+			expected: "Association(Rule(x, 1), Rule(b, 2), Rule(z, 3))",
+		},
+		{
+			name:     "Missing key is ignored",
+			input:    "RenameKeys({a: 1}, q: x)",
+			expected: "Association(Rule(a, 1))",
+		},
+		{
+			name:     "Swap keys",
+			input:    "RenameKeys({a: 1, b: 2}, [a: b, b: a])",
+			expected: "Association(Rule(b, 1), Rule(a, 2))",
+		},
+		{
+			name:     "Collision keeps last value",
+			input:    "RenameKeys({a: 1, b: 2}, a: b)",
+			expected: "Association(Rule(b, 2))",
+		},
+		{
+			name:      "Non-rule in list",
+			input:     "RenameKeys({a: 1}, [a: x, 5])",
+			errorType: "ArgumentError",
+		},
+	}
+
+	runTestCases(t, tests)
+}
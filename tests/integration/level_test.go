@@ -0,0 +1,24 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestLevel(t *testing.T) {
+	tests := []TestCase{
+		{name: "levels 1 through n", input: "Level([[a, b], c], 2)", expected: "List(a, b, List(a, b), c)"},
+		{name: "level 1", input: "Level([[a, b], c], 1)", expected: "List(List(a, b), c)"},
+		{name: "only level n", input: "Level([[a, b], c], [2])", expected: "List(a, b)"},
+		{name: "level 0", input: "Level(x, [0])", expected: "List(x)"},
+		{name: "atoms", input: "Level([[a, b], c], [-1])", expected: "List(a, b, c)"},
+		{name: "depth two parts", input: "Level([[a, b], [c, [d]]], [-2])", expected: "List(List(a, b), List(d))"},
+		{name: "levels 1 through -1", input: "Level(f(g(x), y), -1)", expected: "List(x, g(x), y)"},
+		{name: "mixed positive and negative", input: "Level([[a, b], c], [1, -2])", expected: "List(List(a, b))"},
+		{name: "Infinity", input: "Level([[a, [b]], c], Infinity)", expected: "List(a, b, List(b), List(a, List(b)), c)"},
+		{name: "including whole expression", input: "Level([[a, b], c], [0, Infinity])", expected: "List(a, b, List(a, b), c, List(List(a, b), c))"},
+		{name: "bad spec", input: "Level([a], \"x\")", errorType: "ArgumentError"},
+
+		{name: "MapIndexed at atoms", input: "MapIndexed(f, [[a, b], c], [-1])", expected: "List(List(f(a, List(1, 1)), f(b, List(1, 2))), f(c, List(2)))"},
+	}
+	runTestCases(t, tests)
+}
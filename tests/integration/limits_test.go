@@ -0,0 +1,151 @@
+package integration
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/client9/cardinal"
+	"github.com/client9/cardinal/core"
+)
+
+func TestRecursionLimit(t *testing.T) {
+	e := cardinal.NewEvaluator()
+	eval := func(input string) core.Expr {
+		expr, err := e.ParseString(input)
+		if err != nil {
+			t.Fatalf("%q: %v", input, err)
+		}
+		return e.Evaluate(expr)
+	}
+
+	eval("g(n_) := If(n == 0, 0, g(n - 1))")
+	if result := eval("g(50)"); result.String() != "0" {
+		t.Fatalf("expected g(50) to work with the default limit, got %s", result)
+	}
+
+	e.GetContext().SetRecursionLimit(40)
+	if result := eval("$RecursionLimit"); result.String() != "40" {
+		t.Errorf("expected $RecursionLimit to be 40, got %s", result)
+	}
+	result := eval("g(50)")
+	err, ok := core.AsError(result)
+	if !ok || err.StackTrace()[0].ErrorType != "RecursionError" {
+		t.Fatalf("expected RecursionError, got %s", result)
+	}
+	if msg := err.StackTrace()[0].Message; !strings.Contains(msg, "40") {
+		t.Errorf("expected the message to mention the limit, got %q", msg)
+	}
+
+	// each nested call is one level, and the innermost 1 is one more
+	e.GetContext().SetRecursionLimit(10)
+	nested := func(depth int) string {
+		return strings.Repeat("f(", depth) + "1" + strings.Repeat(")", depth)
+	}
+	if result := eval(nested(9)); result.String() != nested(9) {
+		t.Errorf("expected %s to fit in the limit, got %s", nested(9), result)
+	}
+	result = eval(nested(10))
+	if err, ok := core.AsError(result); !ok || err.StackTrace()[0].ErrorType != "RecursionError" {
+		t.Errorf("expected RecursionError at depth 10, got %s", result)
+	}
+
+	// the language level setting changes the context
+	eval("$RecursionLimit = 2000")
+	if n := e.GetContext().RecursionLimit(); n != 2000 {
+		t.Errorf("expected a recursion limit of 2000, got %d", n)
+	}
+	if result := eval("g(50)"); result.String() != "0" {
+		t.Errorf("expected g(50) to work again, got %s", result)
+	}
+}
+
+func TestIterationLimitSymbol(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "$IterationLimit default",
+			input:    "$IterationLimit",
+			expected: "10000",
+		},
+		{
+			name:      "$IterationLimit limits Table",
+			input:     "$IterationLimit = 3; Table(i, [i, 10])",
+			errorType: "IterationLimitError",
+		},
+		{
+			name:      "$IterationLimit limits Do",
+			input:     "$IterationLimit = 3; Do(i, [i, 10])",
+			errorType: "IterationLimitError",
+		},
+		{
+			name:     "A Table within $IterationLimit",
+			input:    "$IterationLimit = 3; Table(i, [i, 3])",
+			expected: "List(1, 2, 3)",
+		},
+		{
+			name:      "$IterationLimit limits While",
+			input:     "$IterationLimit = 3; i = 0; While(i < 10, i = i + 1)",
+			errorType: "IterationLimitError",
+		},
+		{
+			name:      "$IterationLimit must be an integer",
+			input:     `$IterationLimit = "a"`,
+			errorType: "ArgumentError",
+		},
+		{
+			name:      "$RecursionLimit must be positive",
+			input:     "$RecursionLimit = 0",
+			errorType: "ArgumentError",
+		},
+		{
+			name:      "$RecursionLimit is capped below a Go stack overflow",
+			input:     "$RecursionLimit = 10^8; f(n_) := f(n + 1); f(0)",
+			errorType: "ArgumentError",
+		},
+		{
+			name:     "$RecursionLimit at the cap",
+			input:    "$RecursionLimit = 50000; $RecursionLimit",
+			expected: "50000",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestTimeConstrained(t *testing.T) {
+	tests := []TestCase{
+		{name: "fast expression", input: "TimeConstrained(1 + 1, 1)", expected: "2"},
+		{name: "endless loop", input: "$IterationLimit = 0; TimeConstrained(While(True, 1), 0.1)", expected: "$Aborted"},
+		{name: "sleeping loop", input: "TimeConstrained(While(True, Pause(0.01)), 0.1)", expected: "$Aborted"},
+		{name: "outer limit applies", input: "$IterationLimit = 0; TimeConstrained(TimeConstrained(While(True, 1), 10), 0.1)", expected: "$Aborted"},
+		{name: "inner limit", input: "$IterationLimit = 0; TimeConstrained([TimeConstrained(While(True, 1), 0.05), 2], 10)", expected: "List($Aborted, 2)"},
+		{name: "negative seconds", input: "TimeConstrained(1, -1)", errorType: "ArgumentError"},
+		{name: "symbolic seconds", input: "TimeConstrained(1, x)", errorType: "ArgumentError"},
+		{name: "huge number of seconds", input: "TimeConstrained(1 + 1, 10^12)", expected: "2"},
+	}
+	runTestCases(t, tests)
+}
+
+func TestTimeConstrainedElapsed(t *testing.T) {
+	start := time.Now()
+	result, err := cardinal.EvaluateString("$IterationLimit = 0; TimeConstrained(While(True, 1), 0.05)")
+	elapsed := time.Since(start)
+	if err != nil || result.String() != "$Aborted" {
+		t.Fatalf("expected $Aborted, got %v %v", err, result)
+	}
+	if elapsed > 5*time.Second {
+		t.Errorf("expected TimeConstrained to stop after about 50ms, took %v", elapsed)
+	}
+}
+
+func TestTimeConstrainedPause(t *testing.T) {
+	start := time.Now()
+	result, err := cardinal.EvaluateString("TimeConstrained(Pause(3); 5, 0.05)")
+	elapsed := time.Since(start)
+	if err != nil || result.String() != "$Aborted" {
+		t.Fatalf("expected $Aborted, got %v %v", err, result)
+	}
+	if elapsed > 2*time.Second {
+		t.Errorf("expected Pause to stop at the time limit, took %v", elapsed)
+	}
+}
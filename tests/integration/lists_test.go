@@ -153,6 +153,162 @@ func TestListAccess_Integration(t *testing.T) {
 	runTestCases(t, tests)
 }
 
+func TestListAccessEmptyAndStrings(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:      "First of empty list",
+			input:     "First([])",
+			errorType: "PartError",
+		},
+		{
+			name:      "Last of empty list",
+			input:     "Last([])",
+			errorType: "PartError",
+		},
+		{
+			name:      "First of empty string",
+			input:     `First("")`,
+			errorType: "PartError",
+		},
+		{
+			name:     "Default for empty list",
+			input:    "[First([], 0), Last([], x)]",
+			expected: "List(0, x)",
+		},
+		{
+			name:     "Default is unused for non-empty list",
+			input:    "[First([1, 2], 0), Last([1, 2], 0)]",
+			expected: "List(1, 2)",
+		},
+		{
+			name:     "Strings",
+			input:    `[First("abc"), Last("abc"), Rest("abc"), Most("abc")]`,
+			expected: `List('a', 'c', "bc", "ab")`,
+		},
+		{
+			name:     "Rest and Most of a single element",
+			input:    "[Rest([1]), Most([1])]",
+			expected: "List(List(), List())",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestAppendPrependInsert(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Append and Prepend",
+			input:    "[Append([1, 2], 3), Prepend([2, 3], 1)]",
+			expected: "List(List(1, 2, 3), List(1, 2, 3))",
+		},
+		{
+			name:     "Empty list",
+			input:    "[Append([], 1), Prepend([], 1), Insert([], 1, 1)]",
+			expected: "List(List(1), List(1), List(1))",
+		},
+		{
+			name:     "Strings",
+			input:    `[Append("ab", "c"), Prepend("bc", "a")]`,
+			expected: `List("abc", "abc")`,
+		},
+		{
+			name:     "Original list is unchanged",
+			input:    "a = [1, 2]; b = Prepend(a, 0); [a, b]",
+			expected: "List(List(1, 2), List(0, 1, 2))",
+		},
+		{
+			name:     "Insert at positive positions",
+			input:    "[Insert([a, b, c], x, 1), Insert([a, b, c], x, 2), Insert([a, b, c], x, 4)]",
+			expected: "List(List(x, a, b, c), List(a, x, b, c), List(a, b, c, x))",
+		},
+		{
+			name:     "Insert at negative positions",
+			input:    "[Insert([a, b, c], x, -1), Insert([a, b, c], x, -2), Insert([a, b, c], x, -4)]",
+			expected: "List(List(a, b, c, x), List(a, b, x, c), List(x, a, b, c))",
+		},
+		{
+			name:      "Insert past the end",
+			input:     "Insert([a, b, c], x, 5)",
+			errorType: "PartError",
+		},
+		{
+			name:      "Insert before the start",
+			input:     "Insert([a, b, c], x, -5)",
+			errorType: "PartError",
+		},
+		{
+			name:      "Insert at zero",
+			input:     "Insert([a, b, c], x, 0)",
+			errorType: "PartError",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestDeleteReplacePart(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Delete by index",
+			input:    "[Delete([a, b, c], 2), Delete([a, b, c], -1)]",
+			expected: "List(List(a, c), List(a, b))",
+		},
+		{
+			name:     "Delete several positions",
+			input:    "[Delete([a, b, c, d], [[1], [3]]), Delete([a, b, c], [[1], [-1], [1]])]",
+			expected: "List(List(b, d), List(b))",
+		},
+		{
+			name:     "Delete nested",
+			input:    "[Delete([a, [b, c]], [2, 1]), Delete([a, [b, c], d], [[2, 1], [3]])]",
+			expected: "List(List(a, List(c)), List(a, List(c)))",
+		},
+		{
+			name:     "Delete positions found by Position",
+			input:    "l = [a, [a, b], c]; Delete(l, Position(l, a))",
+			expected: "List(List(b), c)",
+		},
+		{
+			name:      "Delete out of range",
+			input:     "Delete([a, b, c], 4)",
+			errorType: "PartError",
+		},
+		{
+			name:     "ReplacePart by index",
+			input:    "[ReplacePart([a, b, c], 2 : x), ReplacePart([a, b, c], -1 : x)]",
+			expected: "List(List(a, x, c), List(a, b, x))",
+		},
+		{
+			name:     "ReplacePart nested",
+			input:    "ReplacePart([a, [b, [c, d]]], [2, 2, 1] : x)",
+			expected: "List(a, List(b, List(x, d)))",
+		},
+		{
+			name:     "ReplacePart with several rules",
+			input:    "ReplacePart([a, b, c], [1 : x, 3 : y])",
+			expected: "List(x, b, y)",
+		},
+		{
+			name:     "ReplacePart at positions found by Position",
+			input:    "l = [a, [a, b]]; ReplacePart(l, Position(l, a) : z)",
+			expected: "List(z, List(z, b))",
+		},
+		{
+			name:     "ReplacePart of the head",
+			input:    "ReplacePart([1, 2, 3], 0 : Plus)",
+			expected: "6",
+		},
+		{
+			name:      "ReplacePart out of range",
+			input:     "ReplacePart([a, b], [1, 1] : x)",
+			errorType: "PartError",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
 func TestListManipulation_Integration(t *testing.T) {
 	tests := []TestCase{
 		{
@@ -179,3 +335,40 @@ func TestListManipulation_Integration(t *testing.T) {
 
 	runTestCases(t, tests)
 }
+
+func TestDeleteDuplicatesUnion(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "DeleteDuplicates keeps the first of each",
+			input:    "DeleteDuplicates([b, a, b, 1, a, 1])",
+			expected: "List(b, a, 1)",
+		},
+		{
+			name:     "DeleteDuplicates nested lists and associations",
+			input:    "DeleteDuplicates([[1, 2], {x: 1, y: 2}, [1, 2], {y: 2, x: 1}, [2, 1]])",
+			expected: "List(List(1, 2), Association(Rule(x, 1), Rule(y, 2)), List(2, 1))",
+		},
+		{
+			name:     "DeleteDuplicates keeps 1 and 1.0 apart",
+			input:    "DeleteDuplicates([1, 1.0, 1])",
+			expected: "List(1, 1.0)",
+		},
+		{
+			name:     "DeleteDuplicates keeps the head",
+			input:    "DeleteDuplicates(f(x, y, x))",
+			expected: "f(x, y)",
+		},
+		{
+			name:     "DeleteDuplicates empty",
+			input:    "DeleteDuplicates([])",
+			expected: "List()",
+		},
+		{
+			name:     "Union sorts distinct elements",
+			input:    `Union([3, 1, 2, 3], [2, "a", 5, [1]])`,
+			expected: `List(1, 2, 3, 5, "a", List(1))`,
+		},
+	}
+
+	runTestCases(t, tests)
+}
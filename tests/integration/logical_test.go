@@ -221,3 +221,90 @@ func TestAndOrWithVariableAssignments(t *testing.T) {
 		})
 	}
 }
+
+func TestBooleTrueQ(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Boole of booleans",
+			input:    `[Boole(True), Boole(False)]`,
+			expected: "List(1, 0)",
+		},
+		{
+			name:     "Boole of a comparison",
+			input:    `[Boole(3 > 2), Boole(1 == 2)]`,
+			expected: "List(1, 0)",
+		},
+		{
+			name:     "Boole stays symbolic",
+			input:    `[Boole(x), Boole(x == y)]`,
+			expected: "List(Boole(x), Boole(Equal(x, y)))",
+		},
+		{
+			name:     "Boole counts matches",
+			input:    `Plus(Boole(1 < 2), Boole(3 < 2), Boole(2 < 3))`,
+			expected: "2",
+		},
+		{
+			name:     "TrueQ",
+			input:    `[TrueQ(True), TrueQ(False), TrueQ(x), TrueQ(1), TrueQ(x == y)]`,
+			expected: "List(True, False, False, False, False)",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestXorNandNorImplies(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Xor truth table",
+			input:    `[Xor(True, True), Xor(True, False), Xor(False, True), Xor(False, False)]`,
+			expected: "List(False, True, True, False)",
+		},
+		{
+			name:     "Xor of many arguments is parity",
+			input:    `[Xor(True, True, True), Xor(), Xor(True, False, True, False)]`,
+			expected: "List(True, False, False)",
+		},
+		{
+			name:     "Nand truth table",
+			input:    `[Nand(True, True), Nand(True, False), Nand(False, True), Nand(False, False)]`,
+			expected: "List(False, True, True, True)",
+		},
+		{
+			name:     "Nor truth table",
+			input:    `[Nor(True, True), Nor(True, False), Nor(False, True), Nor(False, False)]`,
+			expected: "List(False, False, False, True)",
+		},
+		{
+			name:     "Implies truth table",
+			input:    `[Implies(True, True), Implies(True, False), Implies(False, True), Implies(False, False)]`,
+			expected: "List(True, False, True, True)",
+		},
+		{
+			name:     "Xor partial simplification",
+			input:    `[Xor(False, x), Xor(True, x), Xor(x, y, True)]`,
+			expected: "List(x, Not(x), Not(Xor(x, y)))",
+		},
+		{
+			name:     "Nand and Nor partial simplification",
+			input:    `[Nand(True, x), Nand(x, True, y), Nor(False, x), Nor(x, False, y)]`,
+			expected: "List(Not(x), Nand(x, y), Not(x), Nor(x, y))",
+		},
+		{
+			name:     "Implies partial simplification",
+			input:    `[Implies(True, x), Implies(x, True), Implies(x, False), Implies(x, y)]`,
+			expected: "List(x, True, Not(x), Implies(x, y))",
+		},
+		{
+			name:     "Short-circuit",
+			input:    `n = 0; [Nand(False, n = 1), Nor(True, n = 2), Implies(False, n = 3), n]`,
+			expected: "List(True, False, True, 0)",
+		},
+		{
+			name:     "Comparisons as arguments",
+			input:    `[Xor(1 < 2, 2 < 1), Implies(1 < 2, 2 < 3)]`,
+			expected: "List(True, True)",
+		},
+	}
+	runTestCases(t, tests)
+}
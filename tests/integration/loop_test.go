@@ -0,0 +1,71 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/client9/cardinal"
+	"github.com/client9/cardinal/core"
+)
+
+func TestWhileFor(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "While counts to a target",
+			input:    "i = 0; While(i < 10, i = i + 1); i",
+			expected: "10",
+		},
+		{
+			name:     "While returns Null",
+			input:    "i = 0; While(i < 3, i = i + 1)",
+			expected: "Null",
+		},
+		{
+			name:     "While with a false test never runs the body",
+			input:    "y = 0; While(False, y = 1); y",
+			expected: "0",
+		},
+		{
+			name:     "While with only a test",
+			input:    "n = 0; While((n = n + 1) < 5); n",
+			expected: "5",
+		},
+		{
+			name:     "For sums a range",
+			input:    "total = 0; For(i = 1, i <= 10, i = i + 1, total = total + i); [total, i]",
+			expected: "List(55, 11)",
+		},
+		{
+			name:     "For without a body",
+			input:    "For(k = 0, k < 7, k = k + 1); k",
+			expected: "7",
+		},
+		{
+			name:     "Throw escapes a While",
+			input:    "i = 0; Catch(While(True, i = i + 1; If(i == 4, Throw(i))))",
+			expected: "4",
+		},
+		{
+			name:      "Infinite loop hits the iteration limit",
+			input:     "While(True, 1)",
+			errorType: "IterationLimitError",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestIterationLimit(t *testing.T) {
+	e := cardinal.NewEvaluator()
+	e.GetContext().SetIterationLimit(5)
+
+	expr, _ := e.ParseString("i = 0; While(i < 5, i = i + 1); i")
+	if result := e.Evaluate(expr); result.String() != "5" {
+		t.Errorf("expected 5, got %s", result)
+	}
+
+	expr, _ = e.ParseString("i = 0; While(i < 6, i = i + 1)")
+	result := e.Evaluate(expr)
+	if err, ok := core.AsError(result); !ok || err.StackTrace()[0].ErrorType != "IterationLimitError" {
+		t.Errorf("expected IterationLimitError, got %s", result)
+	}
+}
@@ -167,3 +167,172 @@ func TestMapApply_WithBuiltinFunctions(t *testing.T) {
 	}
 	runTestCases(t, tests)
 }
+
+func TestMapIndexed(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Index is a List",
+			input:    `MapIndexed(f, [a, b, c])`,
+			expected: `List(f(a, List(1)), f(b, List(2)), f(c, List(3)))`,
+		},
+		{
+			name:     "Function receives element and index",
+			input:    `MapIndexed(Function([x, i], x * First(i)), [10, 20, 30])`,
+			expected: `List(10, 40, 90)`,
+		},
+		{
+			name:     "Same length as the input",
+			input:    `Length(MapIndexed(f, [1, 2, 3, 4]))`,
+			expected: `4`,
+		},
+		{
+			name:     "Empty list",
+			input:    `MapIndexed(f, [])`,
+			expected: `List()`,
+		},
+		{
+			name:     "Other heads are kept",
+			input:    `MapIndexed(f, g(a, b))`,
+			expected: `g(f(a, List(1)), f(b, List(2)))`,
+		},
+		{
+			name:     "Only level 2",
+			input:    `MapIndexed(f, [[a, b], [c]], [2])`,
+			expected: `List(List(f(a, List(1, 1)), f(b, List(1, 2))), List(f(c, List(2, 1))))`,
+		},
+		{
+			name:     "Levels 1 through 2, deepest first",
+			input:    `MapIndexed(f, [[a], b], 2)`,
+			expected: `List(f(List(f(a, List(1, 1))), List(1)), f(b, List(2)))`,
+		},
+		{
+			name:     "Level 0 is the whole expression",
+			input:    `MapIndexed(f, [a], [0])`,
+			expected: `f(List(a), List())`,
+		},
+		{
+			name:     "Negative levels count from the bottom",
+			input:    `MapIndexed(f, [[a], b], -1)`,
+			expected: `List(f(List(f(a, List(1, 1))), List(1)), f(b, List(2)))`,
+		},
+		{
+			name:      "Bad level spec",
+			input:     `MapIndexed(f, [a], "x")`,
+			errorType: "ArgumentError",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestMapThread(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Two lists",
+			input:    `MapThread(f, [[a, b], [x, y]])`,
+			expected: `List(f(a, x), f(b, y))`,
+		},
+		{
+			name:     "Three lists",
+			input:    `MapThread(Plus, [[1, 2], [10, 20], [100, 200]])`,
+			expected: `List(111, 222)`,
+		},
+		{
+			name:     "Pure function",
+			input:    `MapThread(Function([x, y], x * y), [[1, 2, 3], [4, 5, 6]])`,
+			expected: `List(4, 10, 18)`,
+		},
+		{
+			name:     "Empty",
+			input:    `[MapThread(f, []), MapThread(f, [[], []])]`,
+			expected: `List(List(), List())`,
+		},
+		{
+			name:     "Level 2",
+			input:    `MapThread(f, [[[a, b], [c, d]], [[u, v], [s, t]]], 2)`,
+			expected: `List(List(f(a, u), f(b, v)), List(f(c, s), f(d, t)))`,
+		},
+		{
+			name:      "Length mismatch",
+			input:     `MapThread(f, [[a, b], [x]])`,
+			errorType: "LengthMismatch",
+		},
+		{
+			name:      "Length mismatch at a deeper level",
+			input:     `MapThread(f, [[[a, b]], [[x]]], 2)`,
+			errorType: "LengthMismatch",
+		},
+		{
+			name:      "Not a list",
+			input:     `MapThread(f, [a, [b]])`,
+			errorType: "ArgumentError",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestOuter(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Outer product",
+			input:    `Outer(Times, [1, 2], [3, 4])`,
+			expected: `List(List(3, 4), List(6, 8))`,
+		},
+		{
+			name:     "Lists of different lengths",
+			input:    `Outer(f, [a, b], [x, y, z])`,
+			expected: `List(List(f(a, x), f(a, y), f(a, z)), List(f(b, x), f(b, y), f(b, z)))`,
+		},
+		{
+			name:     "Rank is the number of lists",
+			input:    `[Outer(f, [a, b]), Outer(f, [a], [b, c], [d])]`,
+			expected: `List(List(f(a), f(b)), List(List(List(f(a, b, d)), List(f(a, c, d)))))`,
+		},
+		{
+			name:     "Empty list",
+			input:    `Outer(f, [], [a])`,
+			expected: `List()`,
+		},
+		{
+			name:     "Non-list argument stays unevaluated",
+			input:    `Outer(f, [a], x)`,
+			expected: `Outer(f, List(a), x)`,
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestThread(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Two lists",
+			input:    `Thread(f([1, 2], [3, 4]))`,
+			expected: `List(f(1, 3), f(2, 4))`,
+		},
+		{
+			name:     "Scalars are repeated",
+			input:    `Thread(f([a, b], x, [c, d]))`,
+			expected: `List(f(a, x, c), f(b, x, d))`,
+		},
+		{
+			name:     "Result is evaluated",
+			input:    `h(x_Integer, y_Integer) := x + y; Thread(h([1, 2], 10))`,
+			expected: `List(11, 12)`,
+		},
+		{
+			name:     "Equations",
+			input:    `Thread(Equal([a, b], [1, 2]))`,
+			expected: `List(Equal(a, 1), Equal(b, 2))`,
+		},
+		{
+			name:     "No lists",
+			input:    `[Thread(f(x, y)), Thread(x)]`,
+			expected: `List(f(x, y), x)`,
+		},
+		{
+			name:      "Lists must have equal length",
+			input:     `Thread(f([a], [b, c]))`,
+			errorType: "LengthMismatch",
+		},
+	}
+	runTestCases(t, tests)
+}
@@ -0,0 +1,86 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/client9/cardinal"
+)
+
+func TestSetDefinition(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Set defines a value for specific arguments",
+			input:    "h(1) = 10; [h(1), h(2)]",
+			expected: "List(10, h(2))",
+		},
+		{
+			name:     "Set evaluates the arguments",
+			input:    "n = 3; h(n + 1) = 7; h(4)",
+			expected: "7",
+		},
+		{
+			name:     "Specific definitions are tried before general ones",
+			input:    "k(n_) := n * 2; k(0) = -1; [k(0), k(5)]",
+			expected: "List(-1, 10)",
+		},
+		{
+			name:     "Set is right associative",
+			input:    "a = b = 3; [a, b]",
+			expected: "List(3, 3)",
+		},
+		{
+			name:      "Set on a Protected function",
+			input:     "Plus(1, 2) = 5",
+			errorType: "Protected",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func fibSteps(t *testing.T, definition string, n string) (string, int64) {
+	t.Helper()
+	e := cardinal.NewEvaluator()
+	e.SetStepLimit(100000000)
+	expr, err := e.ParseString("fib(0) = 0; fib(1) = 1; " + definition + "; fib(" + n + ")")
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	return e.Evaluate(expr).String(), e.Steps()
+}
+
+func TestMemoization(t *testing.T) {
+	result, memoSteps := fibSteps(t, "fib(n_) := fib(n) = fib(n - 1) + fib(n - 2)", "30")
+	if result != "832040" {
+		t.Fatalf("expected fib(30) = 832040, got %s", result)
+	}
+
+	// the naive version of a much smaller fib is already far more work
+	result, naiveSteps := fibSteps(t, "fib(n_) := fib(n - 1) + fib(n - 2)", "20")
+	if result != "6765" {
+		t.Fatalf("expected fib(20) = 6765, got %s", result)
+	}
+	if memoSteps*10 > naiveSteps {
+		t.Errorf("expected memoized fib(30) (%d steps) to be far cheaper than naive fib(20) (%d steps)", memoSteps, naiveSteps)
+	}
+}
+
+// BenchmarkFibonacci evaluates the naive recursive fib, which makes many
+// small integers. Compare allocations with -benchmem.
+func BenchmarkFibonacci(b *testing.B) {
+	e := cardinal.NewEvaluator()
+	setup, err := e.ParseString("fib(0) = 0; fib(1) = 1; fib(n_Integer) := fib(n - 1) + fib(n - 2)")
+	if err != nil {
+		b.Fatal(err)
+	}
+	e.Evaluate(setup)
+	expr, err := e.ParseString("fib(15)")
+	if err != nil {
+		b.Fatal(err)
+	}
+	for b.Loop() {
+		if result := e.Evaluate(expr); result.String() != "610" {
+			b.Fatalf("fib(15) = %s", result)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Sum values with Total",
+			input:    "Merge([{a: 1}, {a: 2, b: 3}], Total)",
+			expected: "Association(Rule(a, 3), Rule(b, 3))",
+		},
+		{
+			name:     "Keys keep first-seen order",
+			input:    "Merge([{b: 1}, {a: 2, b: 3}], Total)",
+			expected: "Association(Rule(b, 4), Rule(a, 2))",
+		},
+		{
+			name:     "Combiner sees the list of values",
+			input:    "Merge([{a: 1}, {a: 2}, {b: 3}], f)",
+			expected: "Association(Rule(a, f(List(1, 2))), Rule(b, f(List(3))))",
+		},
+		{
+			name:     "Empty list",
+			input:    "Merge([], Total)",
+			expected: "Association()",
+		},
+		{
+			name:      "Non-association element",
+			input:     "Merge([{a: 1}, 2], Total)",
+			errorType: "TypeError",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestTotal(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Sum of integers",
+			input:    "Total([1, 2, 3])",
+			expected: "6",
+		},
+		{
+			name:     "Empty list",
+			input:    "Total([])",
+			expected: "0",
+		},
+		{
+			name:     "Symbolic terms",
+			input:    "Total([1, x, 2])",
+			expected: "Plus(3, x)",
+		},
+	}
+	runTestCases(t, tests)
+}
@@ -0,0 +1,93 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestMinMax(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Min of integers",
+			input:    `Min(3, 1, 2)`,
+			expected: "1",
+		},
+		{
+			name:     "Max of integers",
+			input:    `Max(3, 1, 2)`,
+			expected: "3",
+		},
+		{
+			name:     "Single argument",
+			input:    `Max(7)`,
+			expected: "7",
+		},
+		{
+			name:     "List form",
+			input:    `[Min([4, -2, 9]), Max([4, -2, 9])]`,
+			expected: "List(-2, 9)",
+		},
+		{
+			name:     "Mixed Integer and Real keeps the Real",
+			input:    `[Min(1, 0.5, 2), Max(1, 2.5, 2)]`,
+			expected: "List(0.5, 2.5)",
+		},
+		{
+			name:     "Mixed Integer and Real keeps the Integer",
+			input:    `[Min(1.5, 1, 2.5), Max([1.5, 3, 2.5])]`,
+			expected: "List(1, 3)",
+		},
+		{
+			name:     "Rationals",
+			input:    `[Min(1/2, 1/3), Max(1/2, 0.4)]`,
+			expected: "List(1/3, 1/2)",
+		},
+		{
+			name:     "Symbolic arguments stay unevaluated",
+			input:    `Min(1, x)`,
+			expected: "Min(1, x)",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestSign(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Sign of numbers",
+			input:    `[Sign(-5), Sign(0), Sign(3), Sign(-2.5), Sign(1/3)]`,
+			expected: "List(-1, 0, 1, -1, 1)",
+		},
+		{
+			name:     "Symbolic argument",
+			input:    `Sign(x)`,
+			expected: "Sign(x)",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestClip(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Default range",
+			input:    `[Clip(5), Clip(-5), Clip(0.5)]`,
+			expected: "List(1, -1, 0.5)",
+		},
+		{
+			name:     "Explicit range",
+			input:    `[Clip(15, [0, 10]), Clip(-3, [0, 10]), Clip(7, [0, 10])]`,
+			expected: "List(10, 0, 7)",
+		},
+		{
+			name:     "Mixed Integer and Real bounds",
+			input:    `[Clip(2, [0.5, 1.5]), Clip(1.2, [0, 1])]`,
+			expected: "List(1.5, 1)",
+		},
+		{
+			name:     "Symbolic argument",
+			input:    `Clip(x, [0, 1])`,
+			expected: "Clip(x, List(0, 1))",
+		},
+	}
+	runTestCases(t, tests)
+}
@@ -0,0 +1,97 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestModule(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Module with an initial value",
+			input:    "Module([x = 2], x * 10)",
+			expected: "20",
+		},
+		{
+			name:     "Module local does not clobber an outer variable",
+			input:    "x = 5; Module([x = 1], x = x + 1); x",
+			expected: "5",
+		},
+		{
+			name:     "Module local without a value is a fresh symbol",
+			input:    "SymbolQ(Module([x], x))",
+			expected: "True",
+		},
+		{
+			name:     "Module local is renamed",
+			input:    "Module([x], x) === x",
+			expected: "False",
+		},
+		{
+			name:     "Nested Modules get distinct renames",
+			input:    "Module([x], [x, Module([x], x)])[1] === Module([x], [x, Module([x], x)])[2]",
+			expected: "False",
+		},
+		{
+			name:     "Separate calls get distinct renames",
+			input:    "g(_) := Module([t], t); g(1) === g(1)",
+			expected: "False",
+		},
+		{
+			name:     "Initial values are evaluated outside the Module",
+			input:    "y = 3; Module([y = y + 1], y)",
+			expected: "4",
+		},
+		{
+			name:     "Module in a recursive function",
+			input:    "fact(n_) := Module([m = n], If(m <= 1, 1, m * fact(m - 1))); fact(5)",
+			expected: "120",
+		},
+		{
+			name:     "Module with several locals",
+			input:    "Module([a = 1, b = 2, c], c = a + b; c * 2)",
+			expected: "6",
+		},
+		{
+			name:     "A returned Function keeps its local",
+			input:    "Module([x = 1], Function(y, x + y))(2)",
+			expected: "3",
+		},
+		{
+			name:     "A returned pure function keeps its local",
+			input:    "add = Module([n = 10], $ + n &); [add(1), add(2)]",
+			expected: "List(11, 12)",
+		},
+		{
+			name:     "A held local keeps its value",
+			input:    "r = Module([t = 5, u = 1], Hold(u)); ReleaseHold(r)",
+			expected: "1",
+		},
+		{
+			name:     "A definition made in the Module keeps its local",
+			input:    "Module([k = 5], g(y_) := y + k); g(1)",
+			expected: "6",
+		},
+		{
+			name:     "A counter defined in the Module keeps its state",
+			input:    "Module([n = 0], nx(_) := (n = n + 1)); [nx(1), nx(1)]",
+			expected: "List(1, 2)",
+		},
+		{
+			name:     "A delayed value keeps its local",
+			input:    "Module([k = 5], h := k + 1); h",
+			expected: "6",
+		},
+		{
+			name:     "A local definition goes away with its local",
+			input:    "Module([f], f(x_) := x + 1; f(2))",
+			expected: "3",
+		},
+		{
+			name:      "Module variable must be a symbol",
+			input:     "Module([1], 2)",
+			errorType: "ArgumentError",
+		},
+	}
+
+	runTestCases(t, tests)
+}
@@ -0,0 +1,66 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestN(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Constants",
+			input:    `[N(Pi), N(E)]`,
+			expected: "List(3.141592653589793, 2.718281828459045)",
+		},
+		{
+			name:     "Constants stay symbolic without N",
+			input:    `[Pi, E]`,
+			expected: "List(Pi, E)",
+		},
+		{
+			name:     "Integers and rationals",
+			input:    `[N(1/3), N(3), N(-7/2), N(2.5)]`,
+			expected: "List(0.3333333333333333, 3.0, -3.5, 2.5)",
+		},
+		{
+			name:     "Nested numeric expression",
+			input:    `N(Sin(1) + 1/2) == Sin(1.0) + 0.5`,
+			expected: "True",
+		},
+		{
+			name:     "Exact results of numeric functions",
+			input:    `[N(Sqrt(16)), N(Sqrt(1/4))]`,
+			expected: "List(4.0, 0.5)",
+		},
+		{
+			name:     "Symbols are kept",
+			input:    `[N(x), N(x + 1/2), N(f(1/3, Pi))]`,
+			expected: "List(x, Plus(0.5, x), f(0.3333333333333333, 3.141592653589793))",
+		},
+		{
+			name:     "Lists",
+			input:    `N([1/4, [2, Pi]])`,
+			expected: "List(0.25, List(2.0, 3.141592653589793))",
+		},
+		{
+			name:     "Digits at machine precision",
+			input:    `[N(Pi, 10), N(1/3, 5)]`,
+			expected: "List(3.141592653589793, 0.3333333333333333)",
+		},
+		{
+			name:     "Unsupported digits do not error",
+			input:    `[N(Pi, x), N(E, 2.5)]`,
+			expected: "List(N(Pi, x), N(E, 2.5))",
+		},
+		{
+			name:      "Digits too large for int64",
+			input:     `N(Pi, 2^70)`,
+			errorType: "ArgumentError",
+		},
+		{
+			name:      "Too many digits",
+			input:     `N(1/3, 2^40)`,
+			errorType: "ArgumentError",
+		},
+	}
+	runTestCases(t, tests)
+}
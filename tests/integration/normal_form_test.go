@@ -0,0 +1,98 @@
+package integration
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/client9/cardinal"
+)
+
+func TestEvaluatedExpressions(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "A result is evaluated again after a new definition",
+			input:    "r = f(2); f(x_) := x * x; r",
+			expected: "4",
+		},
+		{
+			name:     "A result is evaluated again after a variable changes",
+			input:    "r = g(y); r; y = 2; r",
+			expected: "g(2)",
+		},
+		{
+			name:     "A result is evaluated again after Clear",
+			input:    "y = 2; r := g(y); s = r; Clear(y); [s, r]",
+			expected: "List(g(2), g(y))",
+		},
+		{
+			name:     "A result is evaluated again after an attribute changes",
+			input:    "r = h(b, a); SetAttributes(h, Orderless); r",
+			expected: "h(a, b)",
+		},
+		{
+			name:      "Recursion is still caught",
+			input:     "k(n_) := k(n + 1); k(1)",
+			errorType: "RecursionError",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestEvaluatedExpressionSteps(t *testing.T) {
+	e := cardinal.NewEvaluator()
+	e.SetStepLimit(1000000)
+	expr, err := e.ParseString("f(g(x + 1, y), [1, 2, h(z)])")
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := e.Evaluate(expr)
+	if e.Steps() == 0 {
+		t.Fatal("the first evaluation should take steps")
+	}
+	if again := e.Evaluate(result); !again.Equal(result) || e.Steps() != 0 {
+		t.Errorf("evaluating %s again gave %s in %d steps, want no steps", result, again, e.Steps())
+	}
+}
+
+// arithmeticTree is a balanced tree of Plus and Times with 2^depth
+// leaves, made by leaf
+func arithmeticTree(depth int, next *int, leaf func(int) string) string {
+	if depth == 0 {
+		*next++
+		return leaf(*next)
+	}
+	op := "Plus"
+	if depth%2 == 0 {
+		op = "Times"
+	}
+	return op + "(" + arithmeticTree(depth-1, next, leaf) + ", " + arithmeticTree(depth-1, next, leaf) + ")"
+}
+
+// BenchmarkArithmeticTree evaluates trees with 4096 leaves. The leaves
+// of the symbolic tree are x1, 2, x3, 4 ... so its sums and products
+// stay unevaluated.
+func BenchmarkArithmeticTree(b *testing.B) {
+	leaves := map[string]func(int) string{
+		"Integers": func(i int) string { return fmt.Sprint(i) },
+		"Symbolic": func(i int) string {
+			if i%2 == 1 {
+				return fmt.Sprintf("x%d", i)
+			}
+			return fmt.Sprint(i)
+		},
+	}
+	for _, name := range []string{"Integers", "Symbolic"} {
+		b.Run(name, func(b *testing.B) {
+			e := cardinal.NewEvaluator()
+			n := 0
+			expr, err := e.ParseString(arithmeticTree(12, &n, leaves[name]))
+			if err != nil {
+				b.Fatal(err)
+			}
+			for b.Loop() {
+				e.Evaluate(expr)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/client9/cardinal"
+)
+
+func TestDefineOperator(t *testing.T) {
+	e := cardinal.NewEvaluator()
+
+	steps := []struct {
+		input    string
+		expected string
+	}{
+		{`DefineOperator("~", Plus, 115)`, `Null`},
+		{`1 ~ 2 * 3`, `7`},
+		{`DefineOperator("**", Power, 150, "Right")`, `Null`},
+		{`2 ** 3 ** 2`, `512`},
+		{`OperatorPrecedence("~")`, `115`},
+		{`OperatorPrecedence("*")`, `120`},
+		{`OperatorPrecedence("<>")`, `Missing("NotFound")`},
+	}
+
+	for _, step := range steps {
+		expr, err := e.ParseString(step.input)
+		if err != nil {
+			t.Fatalf("parse error for %q: %v", step.input, err)
+		}
+		result := e.Evaluate(expr)
+		if result.String() != step.expected {
+			t.Errorf("%q: expected %q, got %q", step.input, step.expected, result.String())
+		}
+	}
+
+	// operators are per-evaluator
+	if _, err := cardinal.NewEvaluator().ParseString("1 ~ 2"); err == nil {
+		t.Error("expected parse error in a fresh evaluator")
+	}
+}
+
+func TestDefineOperatorErrors(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:      "Builtin operator",
+			input:     `DefineOperator("+", f, 100)`,
+			errorType: "ArgumentError",
+		},
+		{
+			name:      "Bad associativity",
+			input:     `DefineOperator("~", f, 100, "Up")`,
+			errorType: "ArgumentError",
+		},
+		{
+			name:      "Precedence out of range",
+			input:     `DefineOperator("~", f, 1000)`,
+			errorType: "ArgumentError",
+		},
+	}
+
+	runTestCases(t, tests)
+}
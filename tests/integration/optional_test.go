@@ -0,0 +1,149 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestOptional(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Trailing optional omitted",
+			input:    "f(x_, y_:10) := x + y; f(5)",
+			expected: "15",
+		},
+		{
+			name:     "Trailing optional given",
+			input:    "f(x_, y_:10) := x + y; f(5, 1)",
+			expected: "6",
+		},
+		{
+			name:     "Too many arguments",
+			input:    "f(x_, y_:10) := x + y; f(1, 2, 3)",
+			expected: "f(1, 2, 3)",
+		},
+		{
+			name:     "Too few arguments",
+			input:    "f(x_, y_:10) := x + y; f()",
+			expected: "f()",
+		},
+		{
+			name:     "Several optionals",
+			input:    "g(a_:1, b_:2, c_:3) := [a, b, c]; [g(), g(10), g(10, 20)]",
+			expected: "List(List(1, 2, 3), List(10, 2, 3), List(10, 20, 3))",
+		},
+		{
+			name:     "Optional before a required argument",
+			input:    "h(a_:0, b_) := [a, b]; [h(5), h(1, 5)]",
+			expected: "List(List(0, 5), List(1, 5))",
+		},
+		{
+			name:     "Typed optional is skipped when the type does not match",
+			input:    "k(n_Integer:1, s_String) := [n, s]; [k(\"a\"), k(7, \"a\")]",
+			expected: "List(List(1, \"a\"), List(7, \"a\"))",
+		},
+		{
+			name:     "Definition without optionals is preferred",
+			input:    "p(x_) := \"one\"; p(x_, y_:0) := \"two\"; [p(1), p(1, 2)]",
+			expected: "List(\"one\", \"two\")",
+		},
+		{
+			name:     "A space after the colon",
+			input:    "f(x_: 0) := x; g(x_:1, y_Integer: 2) := [x, y]; [f(), f(3), g(), g(5, 6)]",
+			expected: "List(0, 3, List(1, 2), List(5, 6))",
+		},
+		{
+			name:     "A space before the colon is a rule in a definition too",
+			input:    `h(opt_ : True) := opt; [h(7), h(), h("v" : True), h("v" : False)]`,
+			expected: `List(h(7), h(), "v", h(Rule("v", False)))`,
+		},
+		{
+			name:     "Optional in a conditional definition",
+			input:    "f(x_:0) /; x >= 0 := x; g(Optional(x_, 0)) /; x >= 0 := x; [f(2), f(), f(-1), g(2), g()]",
+			expected: "List(2, 0, f(-1), 2, 0)",
+		},
+		{
+			name:     "A rule argument with patterns on both sides",
+			input:    "f(k_ : v_) := [k, v]; f(a : 1)",
+			expected: "List(a, 1)",
+		},
+		{
+			name:     "A spaced colon outside a definition is a rule",
+			input:    "Cases([1, a], x_Integer : x * 10)",
+			expected: "List(10)",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestDefault(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Omitted arguments take the default",
+			input:    "Default(f) = 0; f(x_., y_.) := [x, y]; [f(), f(1), f(1, 2)]",
+			expected: "List(List(0, 0), List(1, 0), List(1, 2))",
+		},
+		{
+			name:     "Default set after the definition",
+			input:    "f(x_, y_.) := x + y; Default(f) = 10; [f(1), f(1, 2)]",
+			expected: "List(11, 3)",
+		},
+		{
+			name:     "Default changed",
+			input:    "Default(f) = 1; f(x_, y_.) := x * y; a = f(5); Default(f) = 2; [a, f(5)]",
+			expected: "List(5, 10)",
+		},
+		{
+			name:     "Without a default the argument must be given",
+			input:    "f(x_, y_.) := [x, y]; [f(1), f(1, 2)]",
+			expected: "List(f(1), List(1, 2))",
+		},
+		{
+			name:     "Typed argument with a default",
+			input:    `Default(f) = 1; f(n_Integer., s_String) := [n, s]; [f("a"), f(7, "a")]`,
+			expected: `List(List(1, "a"), List(7, "a"))`,
+		},
+		{
+			name:     "An explicit default is kept",
+			input:    "Default(f) = 0; f(x_., y_:5) := [x, y]; f()",
+			expected: "List(0, 5)",
+		},
+		{
+			name:     "Default of a nested function",
+			input:    "Default(h) = 3; g(h(x_.)) := x; [g(h()), g(h(4))]",
+			expected: "List(3, 4)",
+		},
+		{
+			name:     "Default value",
+			input:    "Default(f) = 1; [Default(f), Default(g)]",
+			expected: "List(1, Default(g))",
+		},
+		{
+			name:     "ClearAll removes the default",
+			input:    "Default(f) = 0; ClearAll(f); f(x_.) := x; f()",
+			expected: "f()",
+		},
+		{
+			name:      "Default of a Protected symbol",
+			input:     "Default(Plus) = 0",
+			errorType: "Protected",
+		},
+		{
+			name:      "Default with a position is not supported",
+			input:     "Default(f, 1) = 3",
+			errorType: "ArgumentError",
+		},
+		{
+			name:     "MatchQ uses the default",
+			input:    "Default(f) = 0; [MatchQ(f(), f(x_.)), MatchQ(g(), g(x_.))]",
+			expected: "List(True, False)",
+		},
+		{
+			name:     "Cases and Replace use the default",
+			input:    "Default(f) = 0; [Cases([f(1), f(), g()], f(x_.) : x), Replace(f(), f(y_.) : y + 1)]",
+			expected: "List(List(1, 0), 1)",
+		},
+	}
+
+	runTestCases(t, tests)
+}
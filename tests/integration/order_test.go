@@ -0,0 +1,67 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestOrder(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Numbers before symbols",
+			input:    `[Order(1, x), Order(x, 1)]`,
+			expected: "List(1, -1)",
+		},
+		{
+			name:     "Identical",
+			input:    `[Order(x, x), Order(f(1, "a"), f(1, "a"))]`,
+			expected: "List(0, 0)",
+		},
+		{
+			name:     "Numbers by value",
+			input:    `[Order(9, 10), Order(1/2, 0.6), Order(2.5, 2)]`,
+			expected: "List(1, 1, -1)",
+		},
+		{
+			name:     "Symbols before strings before compound expressions",
+			input:    `[Order(x, "a"), Order("a", f(x)), Order(f(x), "a")]`,
+			expected: "List(1, 1, -1)",
+		},
+		{
+			name:     "Compound expressions by length, head, then elements",
+			input:    `[Order(f(x, y), g(x)), Order(f(x), g(x)), Order(f(9), f(10))]`,
+			expected: "List(-1, 1, 1)",
+		},
+		{
+			name:     "Sort uses the same order",
+			input:    `Sort([f(x), "b", y, 10, "a", x, 1/2, 2.5, 9])`,
+			expected: `List(1/2, 2.5, 9, 10, x, y, "a", "b", f(x))`,
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestOrderedQ(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Sorted list",
+			input:    `OrderedQ([1, 2, x, "a", f(x)])`,
+			expected: "True",
+		},
+		{
+			name:     "Unsorted list",
+			input:    `[OrderedQ([2, 1]), OrderedQ(["a", x])]`,
+			expected: "List(False, False)",
+		},
+		{
+			name:     "Sorted result",
+			input:    `OrderedQ(Sort([c, 3, "s", b, 1]))`,
+			expected: "True",
+		},
+		{
+			name:     "Empty list and other heads",
+			input:    `[OrderedQ([]), OrderedQ(h(1, 2)), OrderedQ(h(2, 1))]`,
+			expected: "List(True, True, False)",
+		},
+	}
+	runTestCases(t, tests)
+}
@@ -0,0 +1,121 @@
+package integration
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/client9/cardinal"
+)
+
+func TestOrderless(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Arguments are sorted",
+			input:    "SetAttributes(g, Orderless); g(c, b, a)",
+			expected: "g(a, b, c)",
+		},
+		{
+			name:     "Sorted arguments are left alone",
+			input:    "SetAttributes(g, Orderless); g(a, b, c)",
+			expected: "g(a, b, c)",
+		},
+		{
+			name:     "Canonical order of mixed arguments",
+			input:    `SetAttributes(g, Orderless); g(2, x, 1.0, 1, "s", f(y))`,
+			expected: `g(1, 1.0, 2, x, "s", f(y))`,
+		},
+		{
+			name:     "Flat and Orderless",
+			input:    "SetAttributes(h, [Flat, Orderless]); h(c, h(b, a))",
+			expected: "h(a, b, c)",
+		},
+		{
+			name:     "Definitions see sorted arguments",
+			input:    "SetAttributes(k, Orderless); k(a, x_) := x; k(z, a)",
+			expected: "z",
+		},
+		{
+			name:     "Held arguments are not sorted",
+			input:    "Hold(Plus(b, a))",
+			expected: "Hold(Plus(b, a))",
+		},
+		{
+			name:     "Plus sorts and flattens",
+			input:    "Plus(y, 1, Plus(x, 2))",
+			expected: "Plus(3, x, y)",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestOrderlessDefinitions(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Definition matches with the arguments swapped",
+			input:    "SetAttributes(f, Orderless); f(x_, 0) := x; f(0, 5)",
+			expected: "5",
+		},
+		{
+			name:     "Definition matches with the arguments in order",
+			input:    "SetAttributes(f, Orderless); f(x_, 0) := x; f(5, 0)",
+			expected: "5",
+		},
+		{
+			name:     "Typed patterns in any order",
+			input:    `SetAttributes(f, Orderless); f(x_Integer, y_String) := [x, y]; f("a", 1)`,
+			expected: `List(1, "a")`,
+		},
+		{
+			name:     "Literal arguments anywhere",
+			input:    "SetAttributes(f, Orderless); f(a, b, x_) := x; [f(c, b, a), f(b, z, a), f(a, b, c, d)]",
+			expected: "List(c, z, f(a, b, c, d))",
+		},
+		{
+			name:     "Six arguments",
+			input:    "SetAttributes(f, Orderless); f(6, 5, 4, 3, 2, x_) := x; f(a, 2, 3, 4, 5, 6)",
+			expected: "a",
+		},
+		{
+			name:     "Condition is checked for each order",
+			input:    "SetAttributes(f, Orderless); f(x_, y_) := x - y /; x > y; [f(1, 3), f(3, 1)]",
+			expected: "List(2, 2)",
+		},
+		{
+			name:     "More specific definitions are still tried first",
+			input:    "SetAttributes(f, Orderless); f(x_, y_) := other; f(x_, 0) := zero; f(0, 5)",
+			expected: "zero",
+		},
+		{
+			name:     "Without Orderless the arguments keep their positions",
+			input:    "f(x_, 0) := x; f(0, 5)",
+			expected: "f(0, 5)",
+		},
+		{
+			name:     "Up-values of an Orderless head",
+			input:    "SetAttributes(f, Orderless); f(c_Circle, z) ^:= c; f(z, Circle(1))",
+			expected: "Circle(1)",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+// BenchmarkOrderlessPlus evaluates a large symbolic sum whose terms are
+// already in canonical order
+func BenchmarkOrderlessPlus(b *testing.B) {
+	terms := make([]string, 500)
+	for i := range terms {
+		terms[i] = fmt.Sprintf("x%03d", i)
+	}
+	eval := cardinal.NewEvaluator()
+	expr, err := cardinal.ParseString("Plus(" + strings.Join(terms, ", ") + ")")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		eval.Evaluate(expr)
+	}
+}
@@ -0,0 +1,61 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/client9/cardinal"
+	"github.com/client9/cardinal/core"
+)
+
+func TestOut(t *testing.T) {
+	e := cardinal.NewEvaluator()
+	c := e.GetContext()
+	eval := func(input string) string {
+		expr, err := e.ParseString(input)
+		if err != nil {
+			t.Fatalf("%q: %v", input, err)
+		}
+		return e.Evaluate(expr).String()
+	}
+
+	if got := eval("%"); got != "Out()" {
+		t.Errorf("expected Out() with no results, got %s", got)
+	}
+	for i := int64(1); i <= 3; i++ {
+		if n := c.AddOutput(core.NewInteger(10 * i)); n != i {
+			t.Errorf("expected result number %d, got %d", i, n)
+		}
+	}
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"%", "30"},
+		{"%%", "20"},
+		{"%%%", "10"},
+		{"%1", "10"},
+		{"Out(2)", "20"},
+		{"Out(-1)", "30"},
+		{"%*2", "60"},
+		{"%4", "Out(4)"},
+		{"%%%%", "Out(-4)"},
+		{"Out(0)", "Out(0)"},
+	}
+	for _, tt := range tests {
+		if got := eval(tt.input); got != tt.expected {
+			t.Errorf("%q: expected %s, got %s", tt.input, tt.expected, got)
+		}
+	}
+
+	// only the most recent results are kept
+	for i := 0; i < 200; i++ {
+		c.AddOutput(core.NewInteger(int64(i)))
+	}
+	if got := eval("%1"); got != "Out(1)" {
+		t.Errorf("expected the first result to be dropped, got %s", got)
+	}
+	if got := eval("%203"); got != "199" {
+		t.Errorf("expected the last result, got %s", got)
+	}
+}
@@ -0,0 +1,42 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestPatternTest(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Predicate accepts integers only",
+			input:    "f(x_?IntegerQ) := int; [f(1), f(1.5), f(\"a\")]",
+			expected: "List(int, f(1.5), f(\"a\"))",
+		},
+		{
+			name:     "Rejected predicate falls back to general definition",
+			input:    "g(x_?IntegerQ) := x + 1; g(x_) := other; [g(1), g(\"a\")]",
+			expected: "List(2, other)",
+		},
+		{
+			name:     "Pure function predicate",
+			input:    "big(x_?Function(v, v > 10)) := True; big(x_) := False; [big(20), big(3)]",
+			expected: "List(True, False)",
+		},
+		{
+			name:     "Anonymous blank with predicate",
+			input:    "h(_?StringQ) := str; [h(\"a\"), h(1)]",
+			expected: "List(str, h(1))",
+		},
+		{
+			name:     "Typed blank with predicate",
+			input:    "pos(x_Integer?Function(v, v > 0)) := x; [pos(3), pos(-3), pos(2.5)]",
+			expected: "List(3, pos(-3), pos(2.5))",
+		},
+		{
+			name:     "Non-True predicate result does not match",
+			input:    "k(x_?undefined) := yes; k(1)",
+			expected: "k(1)",
+		},
+	}
+
+	runTestCases(t, tests)
+}
@@ -0,0 +1,53 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/client9/cardinal"
+	"github.com/client9/cardinal/core"
+)
+
+func TestPause(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Pause returns Null",
+			input:    "Pause(0)",
+			expected: "Null",
+		},
+		{
+			name:      "Negative duration",
+			input:     "Pause(-1)",
+			errorType: "ArgumentError",
+		},
+		{
+			name:      "Duration too long",
+			input:     "Pause(10^10)",
+			errorType: "ArgumentError",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestPauseElapsed(t *testing.T) {
+	start := time.Now()
+	result, err := cardinal.EvaluateString("Pause(0.05)")
+	elapsed := time.Since(start)
+	if err != nil || core.IsError(result) {
+		t.Fatalf("Pause failed: %v %v", err, result)
+	}
+	if elapsed < 50*time.Millisecond || elapsed > 5*time.Second {
+		t.Errorf("expected Pause(0.05) to take about 50ms, took %v", elapsed)
+	}
+}
+
+func TestPauseSafeMode(t *testing.T) {
+	e := cardinal.NewEvaluator()
+	e.SetSafeMode(true)
+	expr, _ := e.ParseString("Pause(1)")
+	result := e.Evaluate(expr)
+	if errExpr, ok := core.AsError(result); !ok || errExpr.StackTrace()[0].ErrorType != "SecurityError" {
+		t.Errorf("expected SecurityError in safe mode, got %s", result)
+	}
+}
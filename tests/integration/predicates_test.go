@@ -0,0 +1,51 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestTypePredicates(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "IntegerQ",
+			input:    `Map(IntegerQ, [1, -7, 1.5, 1/2, "1", x, [1]])`,
+			expected: "List(True, True, False, False, False, False, False)",
+		},
+		{
+			name:     "NumberQ",
+			input:    `Map(NumberQ, [1, 1.5, 1/2, "1", x, [1]])`,
+			expected: "List(True, True, True, False, False, False)",
+		},
+		{
+			name:     "RealQ",
+			input:    `Map(RealQ, [1.5, -0.25, 1, 1/2, "1.5", x])`,
+			expected: "List(True, True, False, False, False, False)",
+		},
+		{
+			name:     "StringQ",
+			input:    `Map(StringQ, ["a", "", 1, a, ["a"]])`,
+			expected: "List(True, True, False, False, False)",
+		},
+		{
+			name:     "ListQ",
+			input:    `Map(ListQ, [[], [1, 2], f(1), "a", x])`,
+			expected: "List(True, True, False, False, False)",
+		},
+		{
+			name:     "AtomQ",
+			input:    `Map(AtomQ, [1, 1.5, "a", x, [1], f(x)])`,
+			expected: "List(True, True, True, True, False, False)",
+		},
+		{
+			name:     "SymbolQ",
+			input:    `Map(SymbolQ, [x, True, 1, "x", f(x)])`,
+			expected: "List(True, True, False, False, False)",
+		},
+		{
+			name:     "Predicates as pattern tests",
+			input:    `f(x_?RealQ) := real; f(x_?ListQ) := list; [f(1.5), f([1]), f(1), f(g(1))]`,
+			expected: "List(real, list, f(1), f(g(1)))",
+		},
+	}
+	runTestCases(t, tests)
+}
@@ -0,0 +1,57 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestRepeated(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Repeated matches one or more",
+			input:    "[MatchQ(f(1, 2, 3), f(_Integer..)), MatchQ(f(), f(_Integer..)), MatchQ(f(1, a), f(_Integer..))]",
+			expected: "List(True, False, False)",
+		},
+		{
+			name:     "RepeatedNull matches zero or more",
+			input:    "[MatchQ(f(), f(_Integer...)), MatchQ(f(1, 2), f(_Integer...))]",
+			expected: "List(True, True)",
+		},
+		{
+			name:     "Repeated exact count",
+			input:    "[MatchQ(f(1, 2), f(Repeated(_Integer, [2]))), MatchQ(f(1, 2, 3), f(Repeated(_Integer, [2])))]",
+			expected: "List(True, False)",
+		},
+		{
+			name:     "Repeated at least",
+			input:    "[MatchQ(f(1), f(Repeated(_, [2, Infinity]))), MatchQ(f(1, 2, 3), f(Repeated(_, [2, Infinity])))]",
+			expected: "List(False, True)",
+		},
+		{
+			name:     "Repeated range",
+			input:    "Map(MatchQ($1, f(Repeated(_Integer, [1, 2]))) &, [f(), f(1), f(1, 2), f(1, 2, 3)])",
+			expected: "List(False, True, True, False)",
+		},
+		{
+			name:     "Repeated followed by other patterns",
+			input:    "[MatchQ(f(1, 2, a), f(_Integer.., a)), MatchQ(f(a), f(_Integer..., a))]",
+			expected: "List(True, True)",
+		},
+		{
+			name:     "Repeated named pattern must be the same value",
+			input:    "[MatchQ(f(1, 1, 1), f(x_..)), MatchQ(f(1, 2), f(x_..))]",
+			expected: "List(True, False)",
+		},
+		{
+			name:     "Repeated in a definition",
+			input:    "ints(_Integer..) := True; ints(___) := False; [ints(1, 2, 3), ints(1, b), ints()]",
+			expected: "List(True, False, False)",
+		},
+		{
+			name:     "Cases with Repeated",
+			input:    "Cases([f(1), f(1, 2), f(a)], f(_Integer..))",
+			expected: "List(f(1), f(1, 2))",
+		},
+	}
+
+	runTestCases(t, tests)
+}
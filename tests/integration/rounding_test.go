@@ -0,0 +1,98 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestFloorCeiling(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Floor",
+			input:    `[Floor(2.7), Floor(-2.7), Floor(3), Floor(-2.0)]`,
+			expected: "List(2, -3, 3, -2)",
+		},
+		{
+			name:     "Ceiling",
+			input:    `[Ceiling(2.2), Ceiling(-2.7), Ceiling(3), Ceiling(2.0)]`,
+			expected: "List(3, -2, 3, 2)",
+		},
+		{
+			name:     "Rationals",
+			input:    `[Floor(7/2), Floor(-7/2), Ceiling(7/2), Ceiling(-7/2)]`,
+			expected: "List(3, -4, 4, -3)",
+		},
+		{
+			name:     "Reals beyond int64 range",
+			input:    `[Floor(1.0*10^19), Ceiling(-1.0*10^19), Floor(2.0^63), Ceiling(-2.0^63 - 4096.0)]`,
+			expected: "List(10000000000000000000, -10000000000000000000, 9223372036854775808, -9223372036854779904)",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestRound(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Nearest integer",
+			input:    `[Round(2.3), Round(2.7), Round(-2.3), Round(-2.7), Round(5)]`,
+			expected: "List(2, 3, -2, -3, 5)",
+		},
+		{
+			name:     "Halfway cases round to even",
+			input:    `[Round(0.5), Round(1.5), Round(2.5), Round(3.5), Round(-2.5), Round(-3.5)]`,
+			expected: "List(0, 2, 2, 4, -2, -4)",
+		},
+		{
+			name:     "Rationals",
+			input:    `[Round(7/3), Round(5/2), Round(7/2), Round(-5/2), Round(-8/3)]`,
+			expected: "List(2, 2, 4, -2, -3)",
+		},
+		{
+			name:     "Nearest multiple",
+			input:    `[Round(7.3, 2), Round(17, 5), Round(-17, 5), Round(7, 1/2)]`,
+			expected: "List(8, 15, -15, 7)",
+		},
+		{
+			name:     "Nearest multiple halfway cases",
+			input:    `[Round(5, 2), Round(15, 10), Round(25, 10)]`,
+			expected: "List(4, 20, 20)",
+		},
+		{
+			name:     "Nearest multiple of a Real",
+			input:    `Round(2.4, 0.5)`,
+			expected: "2.5",
+		},
+		{
+			name:     "Symbolic argument",
+			input:    `Round(x, 2)`,
+			expected: "Round(x, 2)",
+		},
+		{
+			name:      "Multiple of zero",
+			input:     `Round(3, 0)`,
+			errorType: "DivisionByZero",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestIntegerFractionalPart(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "IntegerPart truncates toward zero",
+			input:    `[IntegerPart(2.7), IntegerPart(-2.7), IntegerPart(5), IntegerPart(-7/2)]`,
+			expected: "List(2, -2, 5, -3)",
+		},
+		{
+			name:     "FractionalPart",
+			input:    `[FractionalPart(2.5), FractionalPart(-2.5), FractionalPart(3)]`,
+			expected: "List(0.5, -0.5, 0.0)",
+		},
+		{
+			name:     "FractionalPart of a Rational",
+			input:    `FractionalPart(7/2)`,
+			expected: "0.5",
+		},
+	}
+	runTestCases(t, tests)
+}
@@ -0,0 +1,80 @@
+package integration
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/client9/cardinal"
+	"github.com/client9/cardinal/core"
+)
+
+func TestRunProcess(t *testing.T) {
+	if _, err := exec.LookPath("echo"); err != nil {
+		t.Skip("echo is not available")
+	}
+
+	e := cardinal.NewEvaluator()
+	e.SetAllowExec(true)
+
+	steps := []struct {
+		input    string
+		expected string
+	}{
+		{`r = RunProcess(["echo", "hello"]); r["ExitCode"]`, "0"},
+		{`r["StandardOutput"]`, "\"hello\n\""},
+		{`r["StandardError"]`, `""`},
+	}
+	for _, step := range steps {
+		expr, err := e.ParseString(step.input)
+		if err != nil {
+			t.Fatalf("%q: %v", step.input, err)
+		}
+		if result := e.Evaluate(expr); result.String() != step.expected {
+			t.Errorf("%q: expected %s, got %s", step.input, step.expected, result)
+		}
+	}
+}
+
+func TestRunProcessDisabled(t *testing.T) {
+	tests := []struct {
+		name      string
+		safe      bool
+		allowExec bool
+		errorType string
+	}{
+		{"disabled by default", false, false, "SecurityError"},
+		{"disabled in safe mode", true, true, "SecurityError"},
+	}
+	for _, tt := range tests {
+		e := cardinal.NewEvaluator()
+		e.SetSafeMode(tt.safe)
+		e.SetAllowExec(tt.allowExec)
+
+		expr, _ := e.ParseString(`RunProcess(["echo", "hello"])`)
+		result := e.Evaluate(expr)
+		if errExpr, ok := core.AsError(result); !ok || errExpr.StackTrace()[0].ErrorType != tt.errorType {
+			t.Errorf("%s: expected %s, got %s", tt.name, tt.errorType, result)
+		}
+	}
+}
+
+func TestRunProcessErrors(t *testing.T) {
+	e := cardinal.NewEvaluator()
+	e.SetAllowExec(true)
+
+	tests := []struct {
+		input     string
+		errorType string
+	}{
+		{`RunProcess([])`, "ArgumentError"},
+		{`RunProcess(["echo", 1])`, "TypeError"},
+		{`RunProcess(["cardinal-no-such-command"])`, "ProcessError"},
+	}
+	for _, tt := range tests {
+		expr, _ := e.ParseString(tt.input)
+		result := e.Evaluate(expr)
+		if errExpr, ok := core.AsError(result); !ok || errExpr.StackTrace()[0].ErrorType != tt.errorType {
+			t.Errorf("%q: expected %s, got %s", tt.input, tt.errorType, result)
+		}
+	}
+}
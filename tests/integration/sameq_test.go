@@ -0,0 +1,51 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestSameQEqual(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "SameQ is structural",
+			input:    `[SameQ(1, 1), SameQ(1, 1.0), SameQ(x, x), SameQ(x, y), f(x) === f(x)]`,
+			expected: "List(True, False, True, False, True)",
+		},
+		{
+			name:     "UnsameQ",
+			input:    `[UnsameQ(1, 1.0), x =!= y, x =!= x]`,
+			expected: "List(True, True, False)",
+		},
+		{
+			name:     "Equal compares numbers by value",
+			input:    `[Equal(1, 1.0), 1/2 == 0.5, 2 == 3, 100000000000000000000 == 100000000000000000000.0]`,
+			expected: "List(True, True, False, True)",
+		},
+		{
+			name:     "Equal of identical expressions",
+			input:    `[x == x, f(x) == f(x)]`,
+			expected: "List(True, True)",
+		},
+		{
+			name:     "Equal of distinct atoms",
+			input:    `["a" == "b", "a" == "a", 1 == "a", True == False]`,
+			expected: "List(False, True, False, False)",
+		},
+		{
+			name:     "Symbolic Equal stays unevaluated",
+			input:    `[x == y, 1 == x, f(x) == g(x)]`,
+			expected: "List(Equal(x, y), Equal(1, x), Equal(f(x), g(x)))",
+		},
+		{
+			name:     "Lists compare element by element",
+			input:    `[[1, 2] == [1, 2.0], [1, x] == [2, y], [1] == [1, 2], [1, x] == [1, y]]`,
+			expected: "List(True, False, False, Equal(List(1, x), List(1, y)))",
+		},
+		{
+			name:     "Unequal",
+			input:    `[1 != 1.0, 1 != 2, x != y]`,
+			expected: "List(False, True, Unequal(x, y))",
+		},
+	}
+	runTestCases(t, tests)
+}
@@ -0,0 +1,52 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestSelectFirst(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "First match",
+			input:    "SelectFirst([1, 2, 3, 4], Function(x, x > 2))",
+			expected: "3",
+		},
+		{
+			name:     "Predicate symbol",
+			input:    "SelectFirst([a, \"b\", 3], IntegerQ)",
+			expected: "3",
+		},
+		{
+			name:     "No match returns Missing",
+			input:    "SelectFirst([1, 2], Function(x, x > 5))",
+			expected: "Missing(\"NotFound\")",
+		},
+		{
+			name:     "No match returns default",
+			input:    "SelectFirst([1, 2], Function(x, x > 5), none)",
+			expected: "none",
+		},
+		{
+			name:     "Empty list returns default",
+			input:    "SelectFirst([], IntegerQ, none)",
+			expected: "none",
+		},
+		{
+			name:     "Non-True result is not a match",
+			input:    "SelectFirst([1, 2], Function(x, maybe))",
+			expected: "Missing(\"NotFound\")",
+		},
+		{
+			name:     "Stops at first match",
+			input:    "n = 0; SelectFirst([1, 2, 3, 4, 5], Function(x, n = n + 1; x == 2)); n",
+			expected: "2",
+		},
+		{
+			name:     "Elements after the match are never tested",
+			input:    "SelectFirst([1, 2, 3], Function(x, If(x == 1, True, Assert(False))))",
+			expected: "1",
+		},
+	}
+
+	runTestCases(t, tests)
+}
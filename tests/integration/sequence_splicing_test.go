@@ -40,3 +40,152 @@ func TestSequenceSplicingBasic(t *testing.T) {
 
 	runTestCases(t, tests)
 }
+
+func TestSequenceBindingsInDefinitions(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "A sequence in a list",
+			input:    "f(x__) := [x]; f(a, b, c)",
+			expected: "List(a, b, c)",
+		},
+		{
+			name:     "A sequence on its own is a list",
+			input:    "f(first_, rest__) := rest; f(a, b, c)",
+			expected: "List(b, c)",
+		},
+		{
+			name:     "A sequence in a function call",
+			input:    "g(x__) := h(x); [g(a, b, c), g(a)]",
+			expected: "List(h(a, b, c), h(a))",
+		},
+		{
+			name:     "A sequence used more than once",
+			input:    "g(x__) := h([x], x, k(0, x)); g(a, b)",
+			expected: "h(List(a, b), a, b, k(0, a, b))",
+		},
+		{
+			name:     "An empty sequence",
+			input:    "k(x___) := h(0, x, 9); [k(), k(1), k(1, 2)]",
+			expected: "List(h(0, 9), h(0, 1, 9), h(0, 1, 2, 9))",
+		},
+		{
+			name:     "A sequence before a single argument",
+			input:    "g(x__, y_) := h(y, x); g(1, 2, 3)",
+			expected: "h(3, 1, 2)",
+		},
+		{
+			name:     "A sequence in a condition",
+			input:    "g(x__) := h(x) /; Length([x]) > 1; [g(1), g(1, 2)]",
+			expected: "List(g(1), h(1, 2))",
+		},
+		{
+			name:     "A sequence in an inner function",
+			input:    "g(x__) := Function(t, h(x, t)); g(a, b)(c)",
+			expected: "h(a, b, c)",
+		},
+		{
+			name:     "A sequence of one list keeps the list",
+			input:    "g(x__) := h(x); g([1, 2])",
+			expected: "h(List(1, 2))",
+		},
+		{
+			name:     "A single argument that is a list is not spliced",
+			input:    "g(x_) := h(x); [g([1, 2]), g([])]",
+			expected: "List(h(List(1, 2)), h(List()))",
+		},
+		{
+			name:     "A rule with a single argument that is a list",
+			input:    "ReplaceAll(f([1, 2]), f(x_) : g(x))",
+			expected: "g(List(1, 2))",
+		},
+		{
+			name:     "A repeated sequence variable matches the same elements",
+			input:    "[f(1, 2, 1, 2) /. f(x__, x__) : [x], MatchQ(f(1, 2, 3), f(x__, x__))]",
+			expected: "List(List(1, 2), False)",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestSequenceHead(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "A Sequence is spliced into a call",
+			input:    "f(a, Sequence(b, c), d)",
+			expected: "f(a, b, c, d)",
+		},
+		{
+			name:     "An empty Sequence leaves nothing",
+			input:    "[f(Sequence()), f(a, Sequence(), b)]",
+			expected: "List(f(), f(a, b))",
+		},
+		{
+			name:     "A Sequence of one argument",
+			input:    "f(Sequence(a))",
+			expected: "f(a)",
+		},
+		{
+			name:     "A Sequence on its own is kept",
+			input:    "Sequence(a, b)",
+			expected: "Sequence(a, b)",
+		},
+		{
+			name:     "A Sequence from an evaluated argument",
+			input:    "h(x__) := Sequence(x, x); f(h(1, 2))",
+			expected: "f(1, 2, 1, 2)",
+		},
+		{
+			name:     "Definitions see the spliced arguments",
+			input:    "[Plus(1, Sequence(2, 3)), Length(f(Sequence(a, b), c)), Max(Sequence(1, 5), 3)]",
+			expected: "List(6, 3, 5)",
+		},
+		{
+			name:     "A Sequence in a list",
+			input:    "[1, Sequence(2, 3), 4]",
+			expected: "List(1, 2, 3, 4)",
+		},
+		{
+			name:     "A Sequence in a pure function call",
+			input:    "[(h($$) &)(Sequence(1, 2), 3), (h($2, $1) &)(Sequence(1, 2))]",
+			expected: "List(h(1, 2, 3), h(2, 1))",
+		},
+		{
+			name:     "Map with a function returning a Sequence",
+			input:    "Map(Sequence(0, $) &, [1, 2])",
+			expected: "List(0, 1, 0, 2)",
+		},
+		{
+			name:     "A held Sequence is not spliced",
+			input:    "[Hold(Sequence(a, b)), Hold(f(Sequence(a, b)))]",
+			expected: "List(Hold(Sequence(a, b)), Hold(f(Sequence(a, b))))",
+		},
+		{
+			name:     "Evaluate splices in a held argument",
+			input:    "Hold(Evaluate(Sequence(a, b)))",
+			expected: "Hold(a, b)",
+		},
+		{
+			name:     "Only the held arguments keep a Sequence",
+			input:    "SetAttributes(k, HoldFirst); k(Sequence(a, b), Sequence(c, d))",
+			expected: "k(Sequence(a, b), c, d)",
+		},
+		{
+			name:     "Unevaluated keeps a Sequence",
+			input:    "f(Unevaluated(Sequence(a, b)))",
+			expected: "f(Unevaluated(Sequence(a, b)))",
+		},
+		{
+			name:     "Set keeps a Sequence whole",
+			input:    "s = Sequence(1, 2); g(0, s)",
+			expected: "g(0, 1, 2)",
+		},
+		{
+			name:     "A rule to a Sequence",
+			input:    "[f(a) /. (a : Sequence(1, 2)), Cases([f(1, 2)], f(x__) : Sequence(x))]",
+			expected: "List(f(1, 2), List(1, 2))",
+		},
+	}
+
+	runTestCases(t, tests)
+}
@@ -0,0 +1,52 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestKeySort(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Symbol keys",
+			input:    "KeySort({c: 1, a: 2, b: 3})",
+			expected: "Association(Rule(a, 2), Rule(b, 3), Rule(c, 1))",
+		},
+		{
+			name:     "Same order as Sort",
+			input:    "Keys(KeySort({c: 1, 2: x, a: 2, 1: y})) == Sort(Keys({c: 1, 2: x, a: 2, 1: y}))",
+			expected: "True",
+		},
+		{
+			name:     "Empty association",
+			input:    "KeySort({})",
+			expected: "Association()",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestSortBy(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "List by function",
+			input:    "SortBy([-3, 1, -2], Abs)",
+			expected: "List(1, -2, -3)",
+		},
+		{
+			name:     "Ties keep original order",
+			input:    "SortBy([[b, 1], [a, 1], [c, 0]], Last)",
+			expected: "List(List(c, 0), List(b, 1), List(a, 1))",
+		},
+		{
+			name:     "Association by value",
+			input:    "SortBy({a: 3, b: 1, c: 2}, Function(x, x))",
+			expected: "Association(Rule(b, 1), Rule(c, 2), Rule(a, 3))",
+		},
+		{
+			name:     "Association by derived value",
+			input:    "SortBy({a: -1, b: 3, c: -2}, Abs)",
+			expected: "Association(Rule(a, -1), Rule(c, -2), Rule(b, 3))",
+		},
+	}
+	runTestCases(t, tests)
+}
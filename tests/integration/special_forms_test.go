@@ -117,6 +117,31 @@ func TestTableGeneration(t *testing.T) {
 			input:    `Table(x, 0)`,
 			expected: `List()`,
 		},
+		{
+			name:     "Rectangular nested table",
+			input:    `Table(i*j, [i, 1, 3], [j, 1, 2])`,
+			expected: `List(List(1, 2), List(2, 4), List(3, 6))`,
+		},
+		{
+			name:     "Triangular nested table",
+			input:    `Table(i*j, [i, 1, 3], [j, 1, i])`,
+			expected: `List(List(1), List(2, 4), List(3, 6, 9))`,
+		},
+		{
+			name:     "Three iterators, last varies fastest",
+			input:    `Table(10*i + j, [i, 2], [j, 2], [k, 1])`,
+			expected: `List(List(List(11), List(12)), List(List(21), List(22)))`,
+		},
+		{
+			name:     "Nested simple counts",
+			input:    `Table(0, 2, 3)`,
+			expected: `List(List(0, 0, 0), List(0, 0, 0))`,
+		},
+		{
+			name:     "Nested iterator variables are scoped",
+			input:    `i = 5; j = 6; Table(i*j, [i, 2], [j, 2]); [i, j]`,
+			expected: `List(5, 6)`,
+		},
 	}
 
 	runTestCases(t, tests)
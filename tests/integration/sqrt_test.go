@@ -0,0 +1,87 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestSqrt(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Perfect squares",
+			input:    `[Sqrt(0), Sqrt(1), Sqrt(16), Sqrt(10000000000)]`,
+			expected: "List(0, 1, 4, 100000)",
+		},
+		{
+			name:     "Square factors are pulled out",
+			input:    `Sqrt(12)`,
+			expected: "Times(2, Sqrt(3))",
+		},
+		{
+			name:     "Square free integer",
+			input:    `Sqrt(3)`,
+			expected: "Sqrt(3)",
+		},
+		{
+			name:     "Big perfect square",
+			input:    `Sqrt(100000000000000000000000000000000000000)`,
+			expected: "10000000000000000000",
+		},
+		{
+			name:     "Integers just below 2^64",
+			input:    `[Sqrt(18446744073709551557), Sqrt(18446744073709551556)]`,
+			expected: "List(Sqrt(18446744073709551557), Times(2, Sqrt(4611686018427387889)))",
+		},
+		{
+			name:     "Rational perfect square",
+			input:    `Sqrt(1/4)`,
+			expected: "1/2",
+		},
+		{
+			name:     "Rational partial simplification",
+			input:    `[Sqrt(8/9), Sqrt(1/2)]`,
+			expected: "List(Times(2/3, Sqrt(2)), Times(1/2, Sqrt(2)))",
+		},
+		{
+			name:     "Real",
+			input:    `Sqrt(2.25)`,
+			expected: "1.5",
+		},
+		{
+			name:     "Negative integer stays symbolic",
+			input:    `Sqrt(-4)`,
+			expected: "Sqrt(-4)",
+		},
+		{
+			name:     "Negative real stays symbolic",
+			input:    `Sqrt(-2.0)`,
+			expected: "Sqrt(-2.0)",
+		},
+		{
+			name:     "Symbolic argument",
+			input:    `Sqrt(x)`,
+			expected: "Power(x, 1/2)",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestTimesBigIntegers(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Big integer times machine integer",
+			input:    `100000000000000000000 * 2`,
+			expected: "200000000000000000000",
+		},
+		{
+			name:     "Machine integer product overflows",
+			input:    `4611686018427387904 * 4 * 3`,
+			expected: "55340232221128654848",
+		},
+		{
+			name:     "Big rational plus rational",
+			input:    `1/100000000000000000000 + 1/3`,
+			expected: "100000000000000000003/300000000000000000000",
+		},
+	}
+	runTestCases(t, tests)
+}
@@ -0,0 +1,53 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/client9/cardinal"
+	"github.com/client9/cardinal/core"
+)
+
+func TestStepLimit(t *testing.T) {
+	e := cardinal.NewEvaluator()
+	e.SetStepLimit(1000)
+
+	tests := []struct {
+		input     string
+		expected  string
+		errorType string
+	}{
+		{input: "1 + 2", expected: "3"},
+		{input: "x = 0; Do(x = x + 1, [i, 1000000])", errorType: "StepLimitError"},
+		{input: "f(n_) := f(n + 1); f(0)", errorType: "StepLimitError"},
+		// each evaluation gets a new budget
+		{input: "Table(i, [i, 3])", expected: "List(1, 2, 3)"},
+	}
+	for _, tt := range tests {
+		expr, err := e.ParseString(tt.input)
+		if err != nil {
+			t.Fatalf("%q: %v", tt.input, err)
+		}
+		result := e.Evaluate(expr)
+		if tt.errorType != "" {
+			errExpr, ok := core.AsError(result)
+			if !ok || errExpr.StackTrace()[0].ErrorType != tt.errorType {
+				t.Errorf("%q: expected %s, got %s", tt.input, tt.errorType, result)
+			}
+			continue
+		}
+		if result.String() != tt.expected {
+			t.Errorf("%q: expected %s, got %s", tt.input, tt.expected, result)
+		}
+	}
+}
+
+func TestStepLimitOff(t *testing.T) {
+	e := cardinal.NewEvaluator()
+	expr, _ := e.ParseString("x = 0; Do(x = x + 1, [i, 2000]); x")
+	if result := e.Evaluate(expr); result.String() != "2000" {
+		t.Errorf("expected 2000 with no step limit, got %s", result)
+	}
+	if e.Steps() != 0 {
+		t.Errorf("expected steps not to be counted without a limit, got %d", e.Steps())
+	}
+}
@@ -0,0 +1,345 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestStringJoin(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Join several strings",
+			input:    `StringJoin("a", "bc", "d")`,
+			expected: `"abcd"`,
+		},
+		{
+			name:     "Join a single string",
+			input:    `StringJoin("abc")`,
+			expected: `"abc"`,
+		},
+		{
+			name:     "Empty join",
+			input:    `StringJoin()`,
+			expected: `""`,
+		},
+		{
+			name:     "Multi-byte strings",
+			input:    `StringJoin("héllo", " ", "世界")`,
+			expected: `"héllo 世界"`,
+		},
+		{
+			name:     "Non-string arguments stay unevaluated",
+			input:    `StringJoin("a", x)`,
+			expected: `StringJoin("a", x)`,
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestStringLength(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "ASCII string",
+			input:    `StringLength("hello")`,
+			expected: "5",
+		},
+		{
+			name:     "Empty string",
+			input:    `StringLength("")`,
+			expected: "0",
+		},
+		{
+			name:     "Multi-byte characters are counted once",
+			input:    `StringLength("héllo 世界")`,
+			expected: "8",
+		},
+		{
+			name:     "Length of a join",
+			input:    `StringLength(StringJoin("世", "界"))`,
+			expected: "2",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestStringTake(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "First n characters",
+			input:    `StringTake("hello", 2)`,
+			expected: `"he"`,
+		},
+		{
+			name:     "Last n characters",
+			input:    `StringTake("hello", -2)`,
+			expected: `"lo"`,
+		},
+		{
+			name:     "Single character",
+			input:    `StringTake("hello", [2])`,
+			expected: `"e"`,
+		},
+		{
+			name:     "Span",
+			input:    `StringTake("hello", [2, 4])`,
+			expected: `"ell"`,
+		},
+		{
+			name:     "Span with negative positions",
+			input:    `StringTake("hello", [-3, -1])`,
+			expected: `"llo"`,
+		},
+		{
+			name:     "Multi-byte characters are not split",
+			input:    `StringTake("héllo世界", -3)`,
+			expected: `"o世界"`,
+		},
+		{
+			name:     "Take nothing",
+			input:    `StringTake("hello", 0)`,
+			expected: `""`,
+		},
+		{
+			name:      "Too many characters",
+			input:     `StringTake("hello", 6)`,
+			errorType: "StringPartError",
+		},
+		{
+			name:      "Span out of range",
+			input:     `StringTake("hello", [4, 9])`,
+			errorType: "StringPartError",
+		},
+		{
+			name:      "Count too large for int64",
+			input:     `StringTake("hello", 2^70)`,
+			errorType: "StringPartError",
+		},
+		{
+			name:      "Negative count too large for int64",
+			input:     `StringTake("hello", -2^70)`,
+			errorType: "StringPartError",
+		},
+		{
+			name:      "Span end too large for int64",
+			input:     `StringTake("hello", [1, 2^70])`,
+			errorType: "StringPartError",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestStringDrop(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Drop first n characters",
+			input:    `StringDrop("hello", 2)`,
+			expected: `"llo"`,
+		},
+		{
+			name:     "Drop last n characters",
+			input:    `StringDrop("hello", -2)`,
+			expected: `"hel"`,
+		},
+		{
+			name:     "Drop a single character",
+			input:    `StringDrop("hello", [-1])`,
+			expected: `"hell"`,
+		},
+		{
+			name:     "Drop a span",
+			input:    `StringDrop("héllo", [2, 3])`,
+			expected: `"hlo"`,
+		},
+		{
+			name:      "Position zero",
+			input:     `StringDrop("hello", [0])`,
+			errorType: "StringPartError",
+		},
+		{
+			name:      "Drop count too large for int64",
+			input:     `StringDrop("hello", 2^70)`,
+			errorType: "StringPartError",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestStringReverse(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "ASCII string",
+			input:    `StringReverse("abc")`,
+			expected: `"cba"`,
+		},
+		{
+			name:     "Multi-byte string",
+			input:    `StringReverse("héllo世界")`,
+			expected: `"界世olléh"`,
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestStringSplit(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Split on whitespace",
+			input:    `StringSplit("a b  c")`,
+			expected: `List("a", "b", "c")`,
+		},
+		{
+			name:     "Leading and trailing whitespace is ignored",
+			input:    "StringSplit(\"  a\\tb\\n\")",
+			expected: `List("a", "b")`,
+		},
+		{
+			name:     "Split on a separator",
+			input:    `StringSplit("a,b,c", ",")`,
+			expected: `List("a", "b", "c")`,
+		},
+		{
+			name:     "Consecutive separators give empty fields",
+			input:    `StringSplit("a,,b", ",")`,
+			expected: `List("a", "", "b")`,
+		},
+		{
+			name:     "Multi-character separator",
+			input:    `StringSplit("a::b", "::")`,
+			expected: `List("a", "b")`,
+		},
+		{
+			name:     "Empty string",
+			input:    `StringSplit("")`,
+			expected: `List()`,
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestStringRiffle(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Join with a separator",
+			input:    `StringRiffle(["a", "b", "c"], ", ")`,
+			expected: `"a, b, c"`,
+		},
+		{
+			name:     "Join with spaces",
+			input:    `StringRiffle(["a", "b"])`,
+			expected: `"a b"`,
+		},
+		{
+			name:     "Empty list",
+			input:    `StringRiffle([], ",")`,
+			expected: `""`,
+		},
+		{
+			name:     "Round trip with StringSplit",
+			input:    `StringRiffle(StringSplit("a,,b,c", ","), ",")`,
+			expected: `"a,,b,c"`,
+		},
+		{
+			name:      "Non-string element",
+			input:     `StringRiffle(["a", 1], ",")`,
+			errorType: "TypeError",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestStringReplace(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Single rule",
+			input:    `StringReplace("hello world", "o": "0")`,
+			expected: `"hell0 w0rld"`,
+		},
+		{
+			name:     "Multiple rules",
+			input:    `StringReplace("abc", ["a": "x", "c": "z"])`,
+			expected: `"xbz"`,
+		},
+		{
+			name:     "Replaced text is not matched again",
+			input:    `StringReplace("ab", ["a": "b", "b": "a"])`,
+			expected: `"ba"`,
+		},
+		{
+			name:     "Matches do not overlap",
+			input:    `StringReplace("aaa", "aa": "x")`,
+			expected: `"xa"`,
+		},
+		{
+			name:     "Earlier rules win at the same position",
+			input:    `StringReplace("abc", ["ab": "1", "abc": "2"])`,
+			expected: `"1c"`,
+		},
+		{
+			name:     "Replace with empty string",
+			input:    `StringReplace("a-b-c", "-": "")`,
+			expected: `"abc"`,
+		},
+		{
+			name:     "Multi-byte text",
+			input:    `StringReplace("héllo", "é": "e")`,
+			expected: `"hello"`,
+		},
+		{
+			name:      "Not a rule",
+			input:     `StringReplace("abc", "a")`,
+			errorType: "ArgumentError",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestStringCase(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Upper case",
+			input:    `ToUpperCase("Hello, World")`,
+			expected: `"HELLO, WORLD"`,
+		},
+		{
+			name:     "Lower case",
+			input:    `ToLowerCase("Hello, World")`,
+			expected: `"hello, world"`,
+		},
+		{
+			name:     "Unicode mixed case",
+			input:    `ToUpperCase("ÉcOle Ωmega")`,
+			expected: `"ÉCOLE ΩMEGA"`,
+		},
+		{
+			name:     "Unicode lower case",
+			input:    `ToLowerCase("ÉcOle ΩMEGA")`,
+			expected: `"école ωmega"`,
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestStringTrim(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Trim tabs and spaces",
+			input:    "StringTrim(\"\\t  hello world \\t\\n\")",
+			expected: `"hello world"`,
+		},
+		{
+			name:     "Nothing to trim",
+			input:    `StringTrim("abc")`,
+			expected: `"abc"`,
+		},
+		{
+			name:     "Trim a literal prefix and suffix",
+			input:    `StringTrim("--a--", "-")`,
+			expected: `"-a-"`,
+		},
+		{
+			name:     "Trim only where present",
+			input:    `StringTrim("file.txt", ".txt")`,
+			expected: `"file"`,
+		},
+	}
+	runTestCases(t, tests)
+}
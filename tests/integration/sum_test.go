@@ -0,0 +1,71 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestSumProduct(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Sum of integers",
+			input:    `Sum(i, [i, 1, 10])`,
+			expected: "55",
+		},
+		{
+			name:     "Product of integers",
+			input:    `Product(i, [i, 1, 5])`,
+			expected: "120",
+		},
+		{
+			name:     "Sum is exact",
+			input:    `Sum(1/i, [i, 1, 4])`,
+			expected: "25/12",
+		},
+		{
+			name:     "Product promotes to big integer",
+			input:    `Product(i, [i, 1, 25])`,
+			expected: "15511210043330985984000000",
+		},
+		{
+			name:     "Iterator forms",
+			input:    `[Sum(i, [i, 4]), Sum(i, [i, 10, 1, -3]), Sum(i^2, [i, 2, 3])]`,
+			expected: "List(10, 22, 13)",
+		},
+		{
+			name:     "Empty range",
+			input:    `[Sum(i, [i, 10, 1]), Product(i, [i, 1, 0])]`,
+			expected: "List(0, 1)",
+		},
+		{
+			name:     "Symbolic terms",
+			input:    `Sum(x*i, [i, 1, 3])`,
+			expected: "Plus(x, Times(2, x), Times(3, x))",
+		},
+		{
+			name:     "Iterator variable is scoped",
+			input:    `i = 7; Sum(i, [i, 3]); i`,
+			expected: "7",
+		},
+		{
+			name:      "Not an iterator",
+			input:     `Sum(i, 3)`,
+			errorType: "ArgumentError",
+		},
+		{
+			name:      "Sum past the iteration limit",
+			input:     `Sum(1, [i, 1, 20000])`,
+			errorType: "IterationLimitError",
+		},
+		{
+			name:      "Product past the iteration limit",
+			input:     `Product(1, [i, 1, 20000])`,
+			errorType: "IterationLimitError",
+		},
+		{
+			name:     "Sum with a raised iteration limit",
+			input:    `$IterationLimit = 100000; Sum(1, [i, 1, 20000])`,
+			expected: "20000",
+		},
+	}
+	runTestCases(t, tests)
+}
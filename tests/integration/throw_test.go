@@ -0,0 +1,67 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestThrowCatch(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Catch without a Throw returns the body",
+			input:    "Catch(1 + 2)",
+			expected: "3",
+		},
+		{
+			name:     "Throw from inside Map",
+			input:    "Catch(Map(If($ > 2, Throw($), $) &, [1, 2, 3, 4]))",
+			expected: "3",
+		},
+		{
+			name:     "Throw from inside Table",
+			input:    "Catch(Table(If(i == 3, Throw(i * 10), i), [i, 5]))",
+			expected: "30",
+		},
+		{
+			name:     "Throw unwinds nested calls",
+			input:    "f(x_) := If(x > 2, Throw(x), f(x + 1)); Catch(f(0))",
+			expected: "3",
+		},
+		{
+			name:     "Throw stops the rest of the body",
+			input:    "y = 0; Catch(Throw(1); y = 5); y",
+			expected: "0",
+		},
+		{
+			name:     "Tagged Throw with a matching Catch",
+			input:    `Catch(Throw(1, "a"), "a")`,
+			expected: "1",
+		},
+		{
+			name:     "Catch form is a pattern",
+			input:    "Catch(Throw(1, x), _Symbol)",
+			expected: "1",
+		},
+		{
+			name:     "Unmatched tag goes to the outer Catch",
+			input:    `Catch(Catch(Throw(1, "a"), "b"), "a")`,
+			expected: "1",
+		},
+		{
+			name:      "Unmatched tag is not caught",
+			input:     `Catch(Throw(1, "a"), "b")`,
+			errorType: "Throw",
+		},
+		{
+			name:      "Catch without a form does not catch tagged throws",
+			input:     `Catch(Throw(1, "a"))`,
+			errorType: "Throw",
+		},
+		{
+			name:      "Uncaught Throw",
+			input:     "Throw(5)",
+			errorType: "Throw",
+		},
+	}
+
+	runTestCases(t, tests)
+}
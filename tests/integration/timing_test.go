@@ -0,0 +1,41 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/client9/cardinal"
+	"github.com/client9/cardinal/core"
+)
+
+func TestTiming(t *testing.T) {
+	tests := []TestCase{
+		{name: "Timing result", input: "Last(Timing(1 + 1))", expected: "2"},
+		{name: "AbsoluteTiming result", input: "Last(AbsoluteTiming(1 + 1))", expected: "2"},
+		{name: "Timing is a Real", input: "Head(First(Timing(x)))", expected: "Real"},
+		{name: "AbsoluteTiming is a Real", input: "Head(First(AbsoluteTiming(x)))", expected: "Real"},
+		{name: "Timing evaluates once", input: "n = 0; Timing(n = n + 1); n", expected: "1"},
+		{name: "AbsoluteTiming evaluates once", input: "n = 0; AbsoluteTiming(n = n + 1); n", expected: "1"},
+		{name: "errors are returned", input: "Timing(1/0)", errorType: "DivisionByZero"},
+	}
+	runTestCases(t, tests)
+}
+
+func TestTimingSeconds(t *testing.T) {
+	for _, input := range []string{"Timing(Pause(0.05))", "AbsoluteTiming(Pause(0.05))"} {
+		result, err := cardinal.EvaluateString(input)
+		if err != nil {
+			t.Fatalf("%s: %v", input, err)
+		}
+		list, ok := result.(core.List)
+		if !ok || list.Length() != 2 {
+			t.Fatalf("%s: expected [seconds, result], got %s", input, result)
+		}
+		seconds, ok := core.ExtractFloat64(list.Tail()[0])
+		if !ok || seconds < 0.05 {
+			t.Errorf("%s: expected at least 0.05 seconds, got %s", input, list.Tail()[0])
+		}
+		if list.Tail()[1].String() != "Null" {
+			t.Errorf("%s: expected Null result, got %s", input, list.Tail()[1])
+		}
+	}
+}
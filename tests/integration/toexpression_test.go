@@ -0,0 +1,56 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestToExpression(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Parse and evaluate",
+			input:    `ToExpression("1+2")`,
+			expected: `3`,
+		},
+		{
+			name:     "Uses current definitions",
+			input:    `x = 5; ToExpression("x * 2")`,
+			expected: `10`,
+		},
+		{
+			name:     "Parse a list",
+			input:    `ToExpression("[1, \"a\", b]")`,
+			expected: `List(1, "a", b)`,
+		},
+		{
+			name:     "Hold keeps the expression unevaluated",
+			input:    `ToExpression("1+2", Hold)`,
+			expected: `Hold(Plus(1, 2))`,
+		},
+		{
+			name:     "Round trip through ToString",
+			input:    `ToExpression(ToString(f(x) + 1, InputForm), Hold)`,
+			expected: `Hold(Plus(1, f(x)))`,
+		},
+		{
+			name:      "Malformed input",
+			input:     `ToExpression("f(1, ")`,
+			errorType: "SyntaxError",
+		},
+		{
+			name:      "Malformed input with Hold",
+			input:     `ToExpression("1 + )", Hold)`,
+			errorType: "SyntaxError",
+		},
+		{
+			name:      "Input after the expression",
+			input:     `ToExpression("1 2")`,
+			errorType: "SyntaxError",
+		},
+		{
+			name:      "Unknown wrapper",
+			input:     `ToExpression("1", List)`,
+			errorType: "ArgumentError",
+		},
+	}
+	runTestCases(t, tests)
+}
@@ -0,0 +1,93 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/client9/cardinal"
+	"github.com/client9/cardinal/core"
+)
+
+func TestToString(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "List in OutputForm",
+			input:    `ToString([1, 2, 3])`,
+			expected: `"List(1, 2, 3)"`,
+		},
+		{
+			name:     "List in InputForm",
+			input:    `ToString([1, 2, 3], InputForm)`,
+			expected: `"[1, 2, 3]"`,
+		},
+		{
+			name:     "Strings are not quoted in OutputForm",
+			input:    `ToString("abc")`,
+			expected: `"abc"`,
+		},
+		{
+			name:     "Explicit OutputForm",
+			input:    `ToString("abc", OutputForm)`,
+			expected: `"abc"`,
+		},
+		{
+			name:     "Strings are quoted in InputForm",
+			input:    `StringLength(ToString("abc", InputForm))`,
+			expected: `5`,
+		},
+		{
+			name:     "Operators in InputForm",
+			input:    `ToString(x + 2 * y, InputForm)`,
+			expected: `"x + 2 * y"`,
+		},
+		{
+			name:      "Unknown form",
+			input:     `ToString(1, FullForm)`,
+			errorType: "ArgumentError",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestToStringInputFormRoundTrip(t *testing.T) {
+	inputs := []string{
+		`[1, 2, 3]`,
+		`x + 2 * y`,
+		`(a + b) * c`,
+		`f(x, [y, "z"])`,
+		`{"a": 1, "b": [2, 3]}`,
+		`"quote \" backslash \\ tab \t newline \n"`,
+		`Hold(a - (b - c))`,
+		`Hold((a - b) - c)`,
+		`Hold(a / (b / c) + (a / b) / c)`,
+		`Hold((a: b): c)`,
+		`Hold(a: (b: c))`,
+		`Hold(-x + y^-1)`,
+		`Hold(f(x_, y_Integer, z___) := [x, y, z])`,
+		`Hold(g(x_:0, y_.) /; x > 0 := x)`,
+		`Hold(Cases(l, x_ : 1))`,
+		`Hold({"k": x_})`,
+	}
+	for _, input := range inputs {
+		want, err := cardinal.EvaluateString(input)
+		if err != nil {
+			t.Fatalf("parse error for %q: %v", input, err)
+		}
+		s, err := cardinal.EvaluateString("ToString(" + input + ", InputForm)")
+		if err != nil {
+			t.Fatalf("parse error for ToString(%q): %v", input, err)
+		}
+		str, ok := s.(core.String)
+		if !ok {
+			t.Errorf("ToString(%s, InputForm) returned %s, want a string", input, s)
+			continue
+		}
+		got, err := cardinal.EvaluateString(string(str))
+		if err != nil {
+			t.Errorf("InputForm %s of %q does not parse: %v", str, input, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("round trip of %q: got %s via %s, want %s", input, got, str, want)
+		}
+	}
+}
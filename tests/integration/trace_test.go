@@ -0,0 +1,47 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestTrace(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Trace records Times before Plus",
+			input:    "Trace(2 + 3 * 4)",
+			expected: "List(Hold(Plus(2, Times(3, 4))), List(Hold(Times(3, 4)), Hold(12)), Hold(Plus(2, 12)), Hold(14))",
+		},
+		{
+			name:     "Trace records variable values",
+			input:    "x = 5; Trace(x)",
+			expected: "List(Hold(x), Hold(5))",
+		},
+		{
+			name:     "Trace of an atom is empty",
+			input:    "Trace(1)",
+			expected: "List()",
+		},
+		{
+			name:     "Trace with a head",
+			input:    "Trace(2 + 3 * 4, Times)",
+			expected: "List(List(Hold(Times(3, 4))))",
+		},
+		{
+			name:     "Trace with a pattern",
+			input:    "Trace(2 + 3 * 4, _Integer)",
+			expected: "List(List(Hold(12)), Hold(14))",
+		},
+		{
+			name:     "Trace follows user definitions",
+			input:    "f(n_) := n + 1; Trace(f(1), f(_))",
+			expected: "List(Hold(f(1)))",
+		},
+		{
+			name:     "Trace does not change the result",
+			input:    "Trace(y = 3); y",
+			expected: "3",
+		},
+	}
+
+	runTestCases(t, tests)
+}
@@ -0,0 +1,97 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestTrigSpecialValues(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Zero",
+			input:    `[Sin(0), Cos(0), Tan(0)]`,
+			expected: "List(0, 1, 0)",
+		},
+		{
+			name:     "Multiples of Pi",
+			input:    `[Sin(Pi), Cos(Pi), Tan(Pi), Cos(3*Pi), Sin(-2*Pi)]`,
+			expected: "List(0, -1, 0, -1, 0)",
+		},
+		{
+			name:     "Multiples of Pi/2",
+			input:    `[Sin(Pi/2), Cos(Pi/2), Sin(-Pi/2), Sin(3/2*Pi)]`,
+			expected: "List(1, 0, -1, -1)",
+		},
+		{
+			name:     "Tan pole stays unevaluated",
+			input:    `Tan(Pi/2)`,
+			expected: "Tan(Times(1/2, Pi))",
+		},
+		{
+			name:     "Exact argument stays unevaluated",
+			input:    `[Sin(1), Cos(x), Sin(Pi/3)]`,
+			expected: "List(Sin(1), Cos(x), Sin(Times(1/3, Pi)))",
+		},
+		{
+			name:     "Numeric approximation",
+			input:    `Abs(Sin(1.0) - 0.8414709848) < 10.0^-9`,
+			expected: "True",
+		},
+		{
+			name:     "N of an exact argument",
+			input:    `Abs(N(Cos(Pi/3)) - 0.5) < 10.0^-12`,
+			expected: "True",
+		},
+	}
+	runTestCases(t, tests)
+}
+
+func TestExpLog(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Exp special values",
+			input:    `[Exp(0), Exp(1), Exp(2)]`,
+			expected: "List(1, E, Power(E, 2))",
+		},
+		{
+			name:     "Exp numeric",
+			input:    `Abs(Exp(1.0) - 2.718281828459045) < 10.0^-12`,
+			expected: "True",
+		},
+		{
+			name:     "Log special values",
+			input:    `[Log(1), Log(E), Log(Exp(3))]`,
+			expected: "List(0, 1, 3)",
+		},
+		{
+			name:     "Log exact argument stays unevaluated",
+			input:    `Log(2)`,
+			expected: "Log(2)",
+		},
+		{
+			name:     "Log numeric",
+			input:    `Abs(Log(10.0) - 2.302585092994046) < 10.0^-12`,
+			expected: "True",
+		},
+		{
+			name:     "Log of a negative Real stays unevaluated",
+			input:    `Log(-1.0)`,
+			expected: "Log(-1.0)",
+		},
+		{
+			name:     "Log base exact",
+			input:    `[Log(2, 8), Log(10, 1000), Log(3, 1), Log(b, b)]`,
+			expected: "List(3, 3, 0, 1)",
+		},
+		{
+			name:     "Log base not a power stays unevaluated",
+			input:    `Log(2, 10)`,
+			expected: "Log(2, 10)",
+		},
+		{
+			name:     "Log base numeric",
+			input:    `[Log(2, 1024.0), Log(10, 1000.0), Abs(Log(3, 10.0) - 2.095903274289385) < 10.0^-12]`,
+			expected: "List(10.0, 3.0, True)",
+		},
+	}
+	runTestCases(t, tests)
+}
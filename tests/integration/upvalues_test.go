@@ -0,0 +1,52 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestUpValues(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "UpSetDelayed fires for an argument head",
+			input:    "area(c_Circle) ^:= 3 * radius(c)^2; radius(Circle(r_)) ^:= r; area(Circle(2))",
+			expected: "12",
+		},
+		{
+			name:     "UpSetDelayed returns Null",
+			input:    "area(c_Circle) ^:= 1",
+			expected: "Null",
+		},
+		{
+			name:     "Up-value only applies to its head",
+			input:    "area(c_Circle) ^:= 1; area(Square(1))",
+			expected: "area(Square(1))",
+		},
+		{
+			name:     "UpSet on a symbol",
+			input:    "area(sq) ^= 2 + 2; [area(sq), area(tri)]",
+			expected: "List(4, area(tri))",
+		},
+		{
+			name:     "Up-value in the second argument",
+			input:    "combine(x_, Vec(y_)) ^:= x + y; combine(10, Vec(5))",
+			expected: "15",
+		},
+		{
+			name:     "Ordinary definitions are tried first",
+			input:    "area(c_Circle) ^:= 1; area(x_) := 0; area(Circle(1))",
+			expected: "0",
+		},
+		{
+			name:     "Clear removes up-values",
+			input:    "area(c_Circle) ^:= 1; Clear(Circle); area(Circle(1))",
+			expected: "area(Circle(1))",
+		},
+		{
+			name:      "Up-values cannot attach to Protected symbols",
+			input:     "f(1) ^= 2",
+			errorType: "Protected",
+		},
+	}
+
+	runTestCases(t, tests)
+}
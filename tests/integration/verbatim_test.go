@@ -0,0 +1,52 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestVerbatim(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Verbatim matches a blank literally",
+			input:    "MatchQ(Blank(), Verbatim(Blank()))",
+			expected: "True",
+		},
+		{
+			name:     "A blank matches anything",
+			input:    "MatchQ(5, Blank())",
+			expected: "True",
+		},
+		{
+			name:     "Verbatim does not match other expressions",
+			input:    "MatchQ(5, Verbatim(Blank()))",
+			expected: "False",
+		},
+		{
+			name:     "Verbatim inside a pattern",
+			input:    "[MatchQ(f(x_), f(Verbatim(x_))), MatchQ(f(y_), f(Verbatim(x_))), MatchQ(f(1), f(Verbatim(x_)))]",
+			expected: "List(True, False, False)",
+		},
+		{
+			name:     "Cases finds blanks",
+			input:    "Cases([x_, y, _, 3], Verbatim(_))",
+			expected: "List(Blank())",
+		},
+		{
+			name:     "Replace a pattern in a pattern",
+			input:    "ReplaceAll(f(x_, y), Verbatim(x_) : z)",
+			expected: "f(z, y)",
+		},
+		{
+			name:     "Definition on a literal pattern",
+			input:    "isblank(Verbatim(_)) := True; isblank(_) := False; [isblank(_), isblank(1), isblank(x_)]",
+			expected: "List(True, False, False)",
+		},
+		{
+			name:     "Verbatim matches the same expression, not an equal number",
+			input:    "[MatchQ(1, Verbatim(1)), MatchQ(1, Verbatim(1.0))]",
+			expected: "List(True, False)",
+		},
+	}
+
+	runTestCases(t, tests)
+}
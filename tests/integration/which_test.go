@@ -0,0 +1,79 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestWhich(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Which returns the first True branch",
+			input:    `x = 0; Which(x < 0, "negative", x == 0, "zero", True, "positive")`,
+			expected: `"zero"`,
+		},
+		{
+			name:     "Which falls through to True",
+			input:    `x = 5; Which(x < 0, "negative", x == 0, "zero", True, "positive")`,
+			expected: `"positive"`,
+		},
+		{
+			name:     "Which with no True condition",
+			input:    "Which(False, 1, 1 > 2, 2)",
+			expected: "Null",
+		},
+		{
+			name:     "Which only evaluates the chosen branch",
+			input:    "y = 0; Which(True, 1, True, y = 5); y",
+			expected: "0",
+		},
+		{
+			name:      "Which needs pairs",
+			input:     "Which(True)",
+			errorType: "ArgumentError",
+		},
+	}
+
+	runTestCases(t, tests)
+}
+
+func TestSwitch(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "Switch on a literal",
+			input:    `Switch(2, 1, "one", 2, "two")`,
+			expected: `"two"`,
+		},
+		{
+			name:     "Switch on a pattern",
+			input:    `Switch("hi", _Integer, "integer", _String, "string")`,
+			expected: `"string"`,
+		},
+		{
+			name:     "Switch falls through to the default",
+			input:    `Switch(f(1), _Integer, "integer", _, "other")`,
+			expected: `"other"`,
+		},
+		{
+			name:     "Switch evaluates the expression",
+			input:    `Switch(1 + 1, 1, "one", 2, "two")`,
+			expected: `"two"`,
+		},
+		{
+			name:     "Switch with no match is unevaluated",
+			input:    `Switch(3, 1, "one", 2, "two")`,
+			expected: `Switch(3, 1, "one", 2, "two")`,
+		},
+		{
+			name:     "Switch only evaluates the chosen value",
+			input:    "y = 0; Switch(1, 1, 10, _, y = 5); y",
+			expected: "0",
+		},
+		{
+			name:      "Switch needs pairs",
+			input:     "Switch(1, 1)",
+			errorType: "ArgumentError",
+		},
+	}
+
+	runTestCases(t, tests)
+}
@@ -0,0 +1,52 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestWith(t *testing.T) {
+	tests := []TestCase{
+		{
+			name:     "With substitutes the value",
+			input:    "With(List(Set(a, 5)), a + a)",
+			expected: "10",
+		},
+		{
+			name:     "With does not assign the local",
+			input:    "With([a = 5], a + a); a",
+			expected: "a",
+		},
+		{
+			name:     "With values are evaluated first",
+			input:    "With([x = 1 + 1], Hold(x))",
+			expected: "Hold(2)",
+		},
+		{
+			name:     "Inner With shadows the outer one",
+			input:    "With([x = 1], With([x = 2], x))",
+			expected: "2",
+		},
+		{
+			name:     "Inner initial values see the outer local",
+			input:    "With([x = 1], With([x = x + 1], x))",
+			expected: "2",
+		},
+		{
+			name:     "Function parameter shadows the local",
+			input:    "With([x = 1], Function(x, x + 10)(5))",
+			expected: "15",
+		},
+		{
+			name:     "List values are not spliced",
+			input:    "With([x = [1, 2]], f(x))",
+			expected: "f(List(1, 2))",
+		},
+		{
+			name:      "With requires assignments",
+			input:     "With([x], x)",
+			errorType: "ArgumentError",
+		},
+	}
+
+	runTestCases(t, tests)
+}